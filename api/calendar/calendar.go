@@ -0,0 +1,236 @@
+// Package calendar serves upcoming races and sports events as iCalendar feeds, so punters and partner sites
+// can subscribe to race days and fixtures from their calendar apps.
+package calendar
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/api/proto/racing"
+	"git.neds.sh/matty/entain/api/proto/sports"
+)
+
+const (
+	// prodID identifies the product that built a feed.
+	prodID = "-//Entain//Racing API//EN"
+	// raceUIDFormat and eventUIDFormat build the UIDs of feed entries from their ids,
+	// they never change so calendar apps update an entry in place rather than duplicating it.
+	raceUIDFormat  = "race-%d@racing.entain"
+	eventUIDFormat = "event-%d@sports.entain"
+)
+
+// Handler serves the calendar feeds, reading races and events from their services.
+type Handler struct {
+	racing racing.RacingClient
+	sports sports.SportsClient
+	now    func() time.Time
+}
+
+// NewHandler creates a handler serving feeds read from the racing and sports services.
+func NewHandler(racingClient racing.RacingClient, sportsClient sports.SportsClient) *Handler {
+	return &Handler{racing: racingClient, sports: sportsClient, now: time.Now}
+}
+
+// Register adds the calendar routes to the gateway mux.
+func (h *Handler) Register(mux *runtime.ServeMux) error {
+	if err := mux.HandlePath(http.MethodGet, "/v1/calendar/races.ics", h.serveRaces); err != nil {
+		return err
+	}
+
+	return mux.HandlePath(http.MethodGet, "/v1/calendar/events.ics", h.serveEvents)
+}
+
+// serveRaces renders the races that haven't started yet, soonest first. It accepts the meeting_ids and visibility
+// filters of ListRaces as query parameters, meeting_ids may be repeated or comma separated.
+// The feeds are public, so only visible races are rendered unless visibility asks for others.
+func (h *Handler) serveRaces(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	now := h.now()
+	filter := &racing.ListRacesRequestFilter{
+		AdvertisedStartFrom: timestamppb.New(now),
+		Sort:                []*racing.RaceSort{{Field: racing.RaceSortField_RACE_SORT_FIELD_ADVERTISED_START_TIME}},
+	}
+
+	query := r.URL.Query()
+	for _, param := range query["meeting_ids"] {
+		for _, value := range strings.Split(param, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("meeting_ids: %q is not a meeting id", value), http.StatusBadRequest)
+				return
+			}
+
+			filter.MeetingIds = append(filter.MeetingIds, id)
+		}
+	}
+
+	visibility, ok := parseVisibility(w, query, racing.Visibility_value)
+	if !ok {
+		return
+	}
+	filter.Visibility = racing.Visibility(visibility)
+
+	resp, err := h.racing.ListRaces(r.Context(), &racing.ListRacesRequest{Filter: filter, IncludeMeeting: true})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var ics icsWriter
+	begin(&ics, "Races")
+
+	for _, race := range resp.Races {
+		ics.property("BEGIN", "VEVENT")
+		ics.property("UID", fmt.Sprintf(raceUIDFormat, race.Id))
+		ics.time("DTSTAMP", now)
+		ics.time("DTSTART", race.AdvertisedStartTime.AsTime())
+		// The etag changes with every write, so calendar apps take the latest version of a race.
+		if sequence, err := strconv.ParseInt(race.Etag, 10, 64); err == nil {
+			ics.property("SEQUENCE", strconv.FormatInt(sequence, 10))
+		}
+
+		if meeting := race.Meeting; meeting != nil {
+			ics.text("SUMMARY", fmt.Sprintf("%s R%d %s", meeting.Venue, race.Number, race.Name))
+			ics.text("LOCATION", meeting.Venue+", "+meeting.Country)
+		} else {
+			ics.text("SUMMARY", fmt.Sprintf("R%d %s", race.Number, race.Name))
+		}
+
+		if race.Status == racing.RaceStatus_RACE_STATUS_ABANDONED {
+			ics.property("STATUS", "CANCELLED")
+		} else {
+			ics.property("STATUS", "CONFIRMED")
+		}
+
+		ics.property("END", "VEVENT")
+	}
+
+	end(&ics)
+	write(w, &ics)
+}
+
+// serveEvents renders the sports events that haven't started yet, soonest first.
+// It accepts the visibility filter of ListEvents as a query parameter, only visible events are rendered without it.
+func (h *Handler) serveEvents(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	now := h.now()
+
+	visibility, ok := parseVisibility(w, r.URL.Query(), sports.Visibility_value)
+	if !ok {
+		return
+	}
+
+	resp, err := h.sports.ListEvents(r.Context(), &sports.ListEventsRequest{Filter: &sports.ListEventsRequestFilter{
+		Visibility:          sports.Visibility(visibility),
+		AdvertisedStartFrom: timestamppb.New(now),
+		Column:              "advertised_start_time",
+		OrderBy:             "asc",
+	}})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var ics icsWriter
+	begin(&ics, "Sports events")
+
+	for _, event := range resp.Events {
+		start := event.AdvertisedStartTime.AsTime()
+
+		ics.property("BEGIN", "VEVENT")
+		ics.property("UID", fmt.Sprintf(eventUIDFormat, event.Id))
+		ics.time("DTSTAMP", now)
+		ics.time("DTSTART", start)
+		if event.EndTime != nil && event.EndTime.AsTime().After(start) {
+			ics.time("DTEND", event.EndTime.AsTime())
+		}
+		ics.text("SUMMARY", event.Name)
+		if event.Location != "" {
+			ics.text("LOCATION", event.Location)
+		}
+		switch event.Status {
+		case sports.EventStatus_EVENT_STATUS_CANCELLED:
+			ics.property("STATUS", "CANCELLED")
+		case sports.EventStatus_EVENT_STATUS_POSTPONED:
+			// A postponed event keeps its old time until it is rescheduled.
+			ics.property("STATUS", "TENTATIVE")
+		default:
+			ics.property("STATUS", "CONFIRMED")
+		}
+		ics.property("END", "VEVENT")
+	}
+
+	end(&ics)
+	write(w, &ics)
+}
+
+// parseVisibility reads the visibility query parameter, a value of the service's Visibility enum named in full
+// (VISIBILITY_HIDDEN) or without its prefix (hidden). Without it, the older visible parameter set to false selects
+// every item as it does in the list APIs, and otherwise only visible items are selected.
+// It writes a bad request when either parameter is invalid.
+func parseVisibility(w http.ResponseWriter, query url.Values, names map[string]int32) (int32, bool) {
+	visible := names["VISIBILITY_VISIBLE"]
+
+	if value := query.Get("visible"); value != "" {
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("visible: %q is not a bool", value), http.StatusBadRequest)
+			return 0, false
+		}
+
+		if !v {
+			visible = names["VISIBILITY_ANY"]
+		}
+	}
+
+	value := query.Get("visibility")
+	if value == "" {
+		return visible, true
+	}
+
+	name := strings.ToUpper(value)
+	if !strings.HasPrefix(name, "VISIBILITY_") {
+		name = "VISIBILITY_" + name
+	}
+
+	visibility, ok := names[name]
+	if !ok {
+		http.Error(w, fmt.Sprintf("visibility: %q is not a visibility", value), http.StatusBadRequest)
+		return 0, false
+	}
+
+	return visibility, true
+}
+
+// begin opens a calendar named name.
+func begin(ics *icsWriter, name string) {
+	ics.property("BEGIN", "VCALENDAR")
+	ics.property("VERSION", "2.0")
+	ics.property("PRODID", prodID)
+	ics.property("CALSCALE", "GREGORIAN")
+	ics.property("METHOD", "PUBLISH")
+	ics.text("X-WR-CALNAME", name)
+}
+
+// end closes a calendar.
+func end(ics *icsWriter) {
+	ics.property("END", "VCALENDAR")
+}
+
+// write sends a calendar as the response.
+func write(w http.ResponseWriter, ics *icsWriter) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	_, _ = w.Write([]byte(ics.String()))
+}
+
+// writeError sends the error of a backend call with the HTTP status the gateway gives its gRPC code.
+func writeError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
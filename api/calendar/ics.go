@@ -0,0 +1,53 @@
+package calendar
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the longest a content line may be before it is folded onto the next, CRLF excluded.
+const maxLineOctets = 75
+
+// icsTimeFormat is the UTC date-time format of iCalendar properties.
+const icsTimeFormat = "20060102T150405Z"
+
+// icsEscaper escapes the characters that are special in iCalendar text values.
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// icsWriter builds an iCalendar document, one content line at a time.
+type icsWriter struct {
+	b strings.Builder
+}
+
+// property writes a property with an already encoded value.
+func (w *icsWriter) property(name, value string) {
+	line := name + ":" + value
+
+	// Long lines are folded by starting a continuation line with a space, without splitting a UTF-8 sequence.
+	for limit := maxLineOctets; len(line) > limit; limit = maxLineOctets - 1 {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		w.b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+	}
+
+	w.b.WriteString(line + "\r\n")
+}
+
+// text writes a property with a text value, escaping it.
+func (w *icsWriter) text(name, value string) {
+	w.property(name, icsEscaper.Replace(value))
+}
+
+// time writes a property with a UTC date-time value.
+func (w *icsWriter) time(name string, t time.Time) {
+	w.property(name, t.UTC().Format(icsTimeFormat))
+}
+
+func (w *icsWriter) String() string {
+	return w.b.String()
+}
@@ -5,11 +5,16 @@ import (
 	"flag"
 	"log"
 	"net/http"
+	"net/textproto"
 
+	"git.neds.sh/matty/entain/api/calendar"
 	"git.neds.sh/matty/entain/api/proto/racing"
 	"git.neds.sh/matty/entain/api/proto/sports"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+
+	// Registers the error detail types, so the gateway can render the field violations in error responses.
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
 var (
@@ -21,6 +26,21 @@ var (
 	grpcSportsEndpoint = flag.String("grpc-sports-endpoint", sportsHost, "gRPC server endpoint")
 )
 
+// auditHeaders maps the headers saying who makes a change and why to the metadata the racing service records them from.
+var auditHeaders = map[string]string{
+	"X-Actor":  "x-actor",
+	"X-Reason": "x-reason",
+}
+
+// headerMatcher forwards the audit headers as metadata, along with the headers the gateway forwards by default.
+func headerMatcher(key string) (string, bool) {
+	if name, ok := auditHeaders[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+		return name, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func main() {
 	flag.Parse()
 
@@ -34,7 +54,7 @@ func run() error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(headerMatcher))
 	if err := racing.RegisterRacingHandlerFromEndpoint(
 		ctx,
 		mux,
@@ -53,6 +73,23 @@ func run() error {
 		return err
 	}
 
+	racingConn, err := grpc.DialContext(ctx, *grpcRacingEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer racingConn.Close()
+
+	sportsConn, err := grpc.DialContext(ctx, *grpcSportsEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer sportsConn.Close()
+
+	calendarHandler := calendar.NewHandler(racing.NewRacingClient(racingConn), sports.NewSportsClient(sportsConn))
+	if err := calendarHandler.Register(mux); err != nil {
+		return err
+	}
+
 	log.Printf("API server listening on: %s\n", *apiEndpoint)
 
 	return http.ListenAndServe(*apiEndpoint, mux)
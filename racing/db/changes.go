@@ -0,0 +1,91 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// RaceChange is an entry of the race change log.
+type RaceChange struct {
+	Sequence int64
+	RaceID   int64
+	Kind     racing.RaceChangeKind
+	Time     time.Time
+}
+
+// ChangesRepo provides repository access to the race change log.
+type ChangesRepo interface {
+	// Init will initialise our changes repository.
+	Init() error
+
+	// Migrate will create or upgrade the tables of our changes repository without seeding dummy data.
+	Migrate() error
+
+	// List will return up to limit changes made after the given sequence number, oldest first.
+	List(after int64, limit int) ([]*RaceChange, error)
+
+	// Latest will return the sequence number of the most recent change, zero when nothing has changed.
+	Latest() (int64, error)
+}
+
+type changesRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// NewChangesRepo creates a new changes repository.
+func NewChangesRepo(db *sql.DB) ChangesRepo {
+	return &changesRepo{db: db}
+}
+
+// Init prepares the changes repository table.
+func (c *changesRepo) Init() error {
+	var err error
+
+	c.init.Do(func() {
+		err = c.Migrate()
+	})
+
+	return err
+}
+
+func (c *changesRepo) List(after int64, limit int) ([]*RaceChange, error) {
+	rows, err := c.db.Query(getChangeQueries()[changesList]+" WHERE sequence > ? ORDER BY sequence LIMIT ?", after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []*RaceChange
+
+	for rows.Next() {
+		var change RaceChange
+
+		if err := rows.Scan(&change.Sequence, &change.RaceID, &change.Kind, &change.Time); err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, &change)
+	}
+
+	return changes, rows.Err()
+}
+
+func (c *changesRepo) Latest() (int64, error) {
+	var sequence int64
+
+	err := c.db.QueryRow(`SELECT IFNULL(MAX(sequence), 0) FROM race_changes`).Scan(&sequence)
+
+	return sequence, err
+}
+
+// appendRaceChange logs a change to a race made at the given time, it runs inside the transaction making the change
+// so the log never misses a committed change nor holds a rolled back one.
+func appendRaceChange(tx *sql.Tx, raceID int64, kind racing.RaceChangeKind, at time.Time) error {
+	_, err := tx.Exec(`INSERT INTO race_changes(race_id, kind, change_time) VALUES (?,?,?)`, raceID, kind, at.UTC())
+
+	return err
+}
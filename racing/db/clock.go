@@ -0,0 +1,20 @@
+package db
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Clock returns the current time. Repositories read the time through a Clock rather than the wall clock,
+// so fields derived from it, such as race status, can be evaluated at a fixed instant.
+type Clock func() time.Time
+
+// at returns the instant derived fields are evaluated at, asOf when it is set and the current time otherwise.
+func (c Clock) at(asOf *timestamppb.Timestamp) time.Time {
+	if asOf != nil {
+		return asOf.AsTime()
+	}
+
+	return c()
+}
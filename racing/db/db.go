@@ -1,30 +1,481 @@
 package db
 
 import (
+	"database/sql"
+	"math/rand"
+	"strings"
 	"time"
 
 	"syreclabs.com/go/faker"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
 )
 
-func (r *racesRepo) seed() error {
-	statement, err := r.db.Prepare(`CREATE TABLE IF NOT EXISTS races (id INTEGER PRIMARY KEY, meeting_id INTEGER, name TEXT, number INTEGER, visible INTEGER, advertised_start_time DATETIME)`)
+// seedAudit is recorded in the revision history of the dummy races.
+var seedAudit = Audit{Actor: systemAudit.Actor, Reason: "seeded as dummy data"}
+
+// Seeder stores dummy data in a racing database for test/example purposes.
+type Seeder interface {
+	// Seed will store the dummy data the database was found to need, every table must have been migrated first.
+	Seed() error
+}
+
+type seeder struct {
+	db *sql.DB
+	// races is set when the database held no races, it is seeded with meetings, races, runners and prices.
+	races bool
+	// fields is set when the races were stored by a version predating meetings, they are all dummy races
+	// and are seeded with the meetings, runners and prices they lack.
+	fields bool
+}
+
+// NewSeeder decides which dummy data a racing database needs, it must be called before the tables are migrated.
+// The decision is made once, so races created through the API or imported never get dummy data added to them,
+// and deleted races stay deleted across restarts.
+func NewSeeder(db *sql.DB) (Seeder, error) {
+	s := &seeder{db: db}
+
+	hasRaces, err := hasTable(db, "races")
+	if err != nil {
+		return nil, err
+	}
+
+	hasMeetings, err := hasTable(db, "meetings")
+	if err != nil {
+		return nil, err
+	}
+
+	s.races = !hasRaces
+	if hasRaces {
+		if s.races, err = isEmpty(db, "races"); err != nil {
+			return nil, err
+		}
+	}
+
+	s.fields = hasRaces && !s.races && !hasMeetings
+
+	return s, nil
+}
+
+func (s *seeder) Seed() error {
+	if !s.races && !s.fields {
+		return nil
+	}
+
+	revised, err := revisedRaces(s.db)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Meetings go first, races are indexed for search along with their meeting venue.
+	if err := seedMeetings(tx); err != nil {
+		return err
+	}
+
+	var raceIDs []int64
+	if s.races {
+		if raceIDs, err = seedRaces(tx, revised); err != nil {
+			return err
+		}
+
+		for _, id := range raceIDs {
+			if err := appendRaceRevision(tx, id, racing.RaceChangeKind_RACE_CHANGE_KIND_CREATED, seedAudit, time.Now()); err != nil {
+				return err
+			}
+		}
+	} else if raceIDs, err = storedRaces(tx); err != nil {
+		return err
+	}
+
+	if err := seedRunners(tx, raceIDs); err != nil {
+		return err
+	}
+
+	if err := seedPrices(tx, raceIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// storedRaces returns the ids of every stored race.
+func storedRaces(tx *sql.Tx) ([]int64, error) {
+	rows, err := tx.Query(`SELECT id FROM races ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func (r *racesRepo) Migrate() error {
+	statement, err := r.db.Prepare(`CREATE TABLE IF NOT EXISTS races (id INTEGER PRIMARY KEY, meeting_id INTEGER, name TEXT, number INTEGER, visible INTEGER, advertised_start_time DATETIME, status INTEGER NOT NULL DEFAULT 1, version INTEGER NOT NULL DEFAULT 1)`)
 	if err == nil {
 		_, err = statement.Exec()
 	}
 
-	for i := 1; i <= 100; i++ {
-		statement, err = r.db.Prepare(`INSERT OR IGNORE INTO races(id, meeting_id, name, number, visible, advertised_start_time) VALUES (?,?,?,?,?,?)`)
-		if err == nil {
-			_, err = statement.Exec(
-				i,
-				faker.Number().Between(1, 10),
-				faker.Team().Name(),
-				faker.Number().Between(1, 12),
-				faker.Number().Between(0, 1),
-				faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).Format(time.RFC3339),
-			)
+	if err == nil {
+		err = r.migrateStatus()
+	}
+
+	if err == nil {
+		_, err = addColumn(r.db, "races", "version", "INTEGER NOT NULL DEFAULT 1")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Races seeded by older versions were stored with a local offset.
+	if err := normaliseTimes(r.db, "races", "advertised_start_time"); err != nil {
+		return err
+	}
+
+	return r.seedSearch()
+}
+
+// seedRaces stores the dummy races, returning the ids stored. Revised ids belonged to races that were deleted,
+// they are never seeded again as their history would no longer match the race.
+func seedRaces(tx *sql.Tx, revised map[int64]bool) ([]int64, error) {
+	statement, err := tx.Prepare(`INSERT OR IGNORE INTO races(id, meeting_id, name, number, visible, advertised_start_time, status) VALUES (?,?,?,?,?,?,?)`)
+	if err != nil {
+		return nil, err
+	}
+	defer statement.Close()
+
+	var seeded []int64
+	for i := int64(1); i <= 100; i++ {
+		if revised[i] {
+			continue
+		}
+
+		if _, err := statement.Exec(
+			i,
+			faker.Number().Between(1, len(meetingVenues)),
+			faker.Team().Name(),
+			faker.Number().Between(1, 12),
+			faker.Number().Between(0, 1),
+			faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).UTC(),
+			racing.RaceStatus_RACE_STATUS_OPEN,
+		); err != nil {
+			return nil, err
 		}
+
+		seeded = append(seeded, i)
 	}
 
+	return seeded, nil
+}
+
+// migrateStatus adds the status column to databases created before it existed.
+// Races that have already jumped are backfilled as closed, the rest stay open.
+func (r *racesRepo) migrateStatus() error {
+	added, err := addColumn(r.db, "races", "status", "INTEGER NOT NULL DEFAULT 1")
+	if err != nil || !added {
+		return err
+	}
+
+	rows, err := r.db.Query(`SELECT id, advertised_start_time FROM races`)
+	if err != nil {
+		return err
+	}
+
+	var closed []int64
+	for rows.Next() {
+		var (
+			id              int64
+			advertisedStart time.Time
+		)
+
+		if err := rows.Scan(&id, &advertisedStart); err != nil {
+			rows.Close()
+			return err
+		}
+
+		if r.clock().After(advertisedStart) {
+			closed = append(closed, id)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, id := range closed {
+		if _, err := r.db.Exec(`UPDATE races SET status = ? WHERE id = ?`, racing.RaceStatus_RACE_STATUS_CLOSED, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// meetingVenues are the venues seeded meetings are held at, meeting n is held at meetingVenues[n-1],
+// which is seeded as venue n.
+var meetingVenues = []struct {
+	venue               string
+	city                string
+	country             string
+	raceType            racing.RaceType
+	latitude, longitude float64
+	timezone            string
+}{
+	{"Randwick", "Sydney", "AUS", racing.RaceType_RACE_TYPE_THOROUGHBRED, -33.9106, 151.2290, "Australia/Sydney"},
+	{"Flemington", "Melbourne", "AUS", racing.RaceType_RACE_TYPE_THOROUGHBRED, -37.7878, 144.9118, "Australia/Melbourne"},
+	{"Eagle Farm", "Brisbane", "AUS", racing.RaceType_RACE_TYPE_THOROUGHBRED, -27.4317, 153.0700, "Australia/Brisbane"},
+	{"Morphettville", "Adelaide", "AUS", racing.RaceType_RACE_TYPE_THOROUGHBRED, -34.9800, 138.5420, "Australia/Adelaide"},
+	{"Ellerslie", "Auckland", "NZL", racing.RaceType_RACE_TYPE_THOROUGHBRED, -36.8963, 174.8085, "Pacific/Auckland"},
+	{"Menangle", "Menangle", "AUS", racing.RaceType_RACE_TYPE_HARNESS, -34.1222, 150.7431, "Australia/Sydney"},
+	{"Melton", "Melton", "AUS", racing.RaceType_RACE_TYPE_HARNESS, -37.6710, 144.5650, "Australia/Melbourne"},
+	{"Addington", "Christchurch", "NZL", racing.RaceType_RACE_TYPE_HARNESS, -43.5443, 172.6165, "Pacific/Auckland"},
+	{"Wentworth Park", "Sydney", "AUS", racing.RaceType_RACE_TYPE_GREYHOUND, -33.8770, 151.1940, "Australia/Sydney"},
+	{"The Meadows", "Melbourne", "AUS", racing.RaceType_RACE_TYPE_GREYHOUND, -37.6640, 144.9430, "Australia/Melbourne"},
+}
+
+func (m *meetingsRepo) Migrate() error {
+	statement, err := m.db.Prepare(`CREATE TABLE IF NOT EXISTS meetings (id INTEGER PRIMARY KEY, venue TEXT, country TEXT, race_type INTEGER, date TEXT, track_condition INTEGER, venue_id INTEGER NOT NULL DEFAULT 0)`)
+	if err == nil {
+		_, err = statement.Exec()
+	}
+
+	if err == nil {
+		_, err = addColumn(m.db, "meetings", "venue_id", "INTEGER NOT NULL DEFAULT 0")
+	}
+
+	return err
+}
+
+// seedMeetings stores the dummy meetings the seeded races point at, meeting n is held at venue n.
+func seedMeetings(tx *sql.Tx) error {
+	statement, err := tx.Prepare(`INSERT OR IGNORE INTO meetings(id, venue, country, race_type, date, track_condition, venue_id) VALUES (?,?,?,?,?,?,?)`)
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	for i, v := range meetingVenues {
+		if _, err := statement.Exec(
+			i+1,
+			v.venue,
+			v.country,
+			v.raceType,
+			faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).Format("2006-01-02"),
+			faker.Number().Between(1, 5),
+			i+1,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runnersPerRace returns the field size of a seeded race, between 6 and 14 runners.
+func runnersPerRace(raceID int64) int {
+	return 6 + int(raceID%9)
+}
+
+// runnerID returns the id of the seeded runner with a saddle number in a race.
+func runnerID(raceID int64, saddle int) int64 {
+	return raceID*100 + int64(saddle)
+}
+
+// Migrate creates the runners table, the dummy fields are seeded along with the races they run in.
+func (r *runnersRepo) Migrate() error {
+	statement, err := r.db.Prepare(`CREATE TABLE IF NOT EXISTS runners (id INTEGER PRIMARY KEY, race_id INTEGER NOT NULL, saddle_number INTEGER, barrier INTEGER, name TEXT, jockey TEXT, trainer TEXT, weight REAL, scratched INTEGER NOT NULL DEFAULT 0, scratch_time DATETIME)`)
+	if err == nil {
+		_, err = statement.Exec()
+	}
+
+	return err
+}
+
+// seedRunners stores a dummy field for each of the given races.
+func seedRunners(tx *sql.Tx, raceIDs []int64) error {
+	statement, err := tx.Prepare(`INSERT OR IGNORE INTO runners(id, race_id, saddle_number, barrier, name, jockey, trainer, weight, scratched, scratch_time) VALUES (?,?,?,?,?,?,?,?,?,?)`)
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	for _, raceID := range raceIDs {
+		fieldSize := runnersPerRace(raceID)
+		barriers := rand.Perm(fieldSize)
+
+		for saddle := 1; saddle <= fieldSize; saddle++ {
+			var scratchTime interface{}
+			scratched := faker.Number().Between(1, 20) == "1"
+			if scratched {
+				scratchTime = faker.Time().Between(time.Now().AddDate(0, 0, -2), time.Now().AddDate(0, 0, -1)).UTC()
+			}
+
+			// Runner ids are derived from the race and saddle number, so a field never collides with another race's.
+			if _, err := statement.Exec(
+				runnerID(raceID, saddle),
+				raceID,
+				saddle,
+				barriers[saddle-1]+1,
+				strings.Title(faker.Commerce().Color())+" "+faker.Name().FirstName(),
+				faker.Name().Name(),
+				faker.Name().Name(),
+				float64(540+rand.Intn(61))/10,
+				scratched,
+				scratchTime,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Migrate creates the results tables, results are only ever recorded through the API so there is no dummy data.
+func (r *resultsRepo) Migrate() error {
+	statement, err := r.db.Prepare(`CREATE TABLE IF NOT EXISTS race_results (race_id INTEGER PRIMARY KEY, protest_status INTEGER NOT NULL, recorded_time DATETIME NOT NULL)`)
+	if err == nil {
+		_, err = statement.Exec()
+	}
+
+	if err == nil {
+		statement, err = r.db.Prepare(`CREATE TABLE IF NOT EXISTS race_placings (race_id INTEGER NOT NULL, runner_id INTEGER NOT NULL, position INTEGER NOT NULL, margin REAL NOT NULL DEFAULT 0, PRIMARY KEY (race_id, runner_id))`)
+	}
+	if err == nil {
+		_, err = statement.Exec()
+	}
+
+	return err
+}
+
+// pricesPerRunner is the number of price moves seeded for every runner.
+const pricesPerRunner = 3
+
+// Migrate creates the prices table, the dummy price moves are seeded along with the runners they price.
+func (p *pricesRepo) Migrate() error {
+	statement, err := p.db.Prepare(`CREATE TABLE IF NOT EXISTS runner_prices (id INTEGER PRIMARY KEY, race_id INTEGER NOT NULL, runner_id INTEGER NOT NULL, win REAL NOT NULL, place REAL NOT NULL, updated_time DATETIME NOT NULL)`)
+	if err == nil {
+		_, err = statement.Exec()
+	}
+	if err == nil {
+		_, err = p.db.Exec(`CREATE INDEX IF NOT EXISTS runner_prices_race_runner ON runner_prices (race_id, runner_id)`)
+	}
+
+	return err
+}
+
+// seedPrices stores a few dummy price moves for every runner seeded for the given races.
+func seedPrices(tx *sql.Tx, raceIDs []int64) error {
+	statement, err := tx.Prepare(`INSERT OR IGNORE INTO runner_prices(id, race_id, runner_id, win, place, updated_time) VALUES (?,?,?,?,?,?)`)
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	for _, raceID := range raceIDs {
+		for saddle := 1; saddle <= runnersPerRace(raceID); saddle++ {
+			runnerID := runnerID(raceID, saddle)
+			updatedTime := time.Now().AddDate(0, 0, -3)
+
+			for move := int64(1); move <= pricesPerRunner; move++ {
+				win := float64(150+rand.Intn(4900)) / 100
+				updatedTime = updatedTime.Add(time.Duration(1+rand.Intn(60)) * time.Minute)
+
+				// Price ids are derived from the runner and move, so a history never collides with another runner's.
+				if _, err := statement.Exec(
+					runnerID*10+move,
+					raceID,
+					runnerID,
+					win,
+					placePrice(win),
+					updatedTime.UTC(),
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// placePrice approximates the place odds paid for a win price, a quarter of the win odds.
+func placePrice(win float64) float64 {
+	return float64(int(100+(win-1)*25)) / 100
+}
+
+// Migrate creates the race change log, changes are only ever logged by updates so there is no dummy data.
+func (c *changesRepo) Migrate() error {
+	statement, err := c.db.Prepare(`CREATE TABLE IF NOT EXISTS race_changes (sequence INTEGER PRIMARY KEY AUTOINCREMENT, race_id INTEGER NOT NULL, kind INTEGER NOT NULL, change_time DATETIME NOT NULL)`)
+	if err == nil {
+		_, err = statement.Exec()
+	}
+
+	return err
+}
+
+// Migrate creates the race revision history, backfilling a revision for every race stored before the history was kept.
+func (r *revisionsRepo) Migrate() error {
+	statement, err := r.db.Prepare(`CREATE TABLE IF NOT EXISTS race_revisions (race_id INTEGER NOT NULL, revision INTEGER NOT NULL, kind INTEGER NOT NULL, actor TEXT NOT NULL, reason TEXT NOT NULL, change_time DATETIME NOT NULL, meeting_id INTEGER, name TEXT, number INTEGER, visible INTEGER, advertised_start_time DATETIME, status INTEGER NOT NULL, PRIMARY KEY (race_id, revision))`)
+	if err == nil {
+		_, err = statement.Exec()
+	}
+
+	// Changes to what the race snapshot doesn't hold, such as its runners, are recorded alongside the revision.
+	if err == nil {
+		_, err = r.db.Exec(`CREATE TABLE IF NOT EXISTS race_revision_changes (race_id INTEGER NOT NULL, revision INTEGER NOT NULL, field TEXT NOT NULL, old_value TEXT NOT NULL, new_value TEXT NOT NULL)`)
+	}
+
+	if err == nil {
+		_, err = r.db.Exec(
+			`INSERT INTO race_revisions(race_id, revision, kind, actor, reason, change_time, meeting_id, name, number, visible, advertised_start_time, status)
+			SELECT id, version, ?, ?, ?, ?, meeting_id, name, number, visible, advertised_start_time, status FROM races
+			WHERE id NOT IN (SELECT race_id FROM race_revisions)`,
+			racing.RaceChangeKind_RACE_CHANGE_KIND_CREATED,
+			systemAudit.Actor,
+			"recorded before revision history was kept",
+			time.Now().UTC(),
+		)
+	}
+
+	return err
+}
+
+// Migrate creates the venues table with the racecourses meetings are held at, these are reference data rather than
+// dummy data, so meetings can be matched to venues by name.
+func (v *venuesRepo) Migrate() error {
+	if _, err := v.db.Exec(`CREATE TABLE IF NOT EXISTS venues (id INTEGER PRIMARY KEY, name TEXT, city TEXT, country TEXT, latitude REAL, longitude REAL, timezone TEXT)`); err != nil {
+		return err
+	}
+
+	for i, m := range meetingVenues {
+		_, err := v.db.Exec(
+			`INSERT OR IGNORE INTO venues(id, name, city, country, latitude, longitude, timezone) VALUES (?,?,?,?,?,?,?)`,
+			i+1, m.venue, m.city, m.country, m.latitude, m.longitude, m.timezone,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Meetings stored before venues existed are matched to them by name.
+	_, err := v.db.Exec(`UPDATE meetings SET venue_id = IFNULL((SELECT id FROM venues WHERE venues.name = meetings.venue), 0) WHERE venue_id = 0`)
+
 	return err
 }
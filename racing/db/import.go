@@ -0,0 +1,177 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// ImportSummary counts the meetings and races an import created and updated.
+// Rows matching what is already stored are left untouched and counted as neither.
+type ImportSummary struct {
+	MeetingsCreated int
+	MeetingsUpdated int
+	RacesCreated    int
+	RacesUpdated    int
+}
+
+// ImportRepo provides bulk loading of meetings and races.
+// The tables it writes are created by the meetings, venues, races, changes and revisions repositories, which must be initialised first.
+type ImportRepo interface {
+	// Import will upsert meetings and then races by id in a single transaction, so either every row is stored or none is.
+	// Imported races are logged and recorded in the revision history like any other write.
+	Import(meetings []*racing.Meeting, races []*racing.Race, audit Audit) (*ImportSummary, error)
+}
+
+type importRepo struct {
+	db    *sql.DB
+	clock Clock
+}
+
+// NewImportRepo creates a new import repository, reading the time imported races are logged at from clock.
+func NewImportRepo(db *sql.DB, clock Clock) ImportRepo {
+	return &importRepo{db: db, clock: clock}
+}
+
+func (i *importRepo) Import(meetings []*racing.Meeting, races []*racing.Race, audit Audit) (*ImportSummary, error) {
+	tx, err := i.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var summary ImportSummary
+
+	for _, meeting := range meetings {
+		created, updated, err := upsertMeeting(tx, meeting)
+		if err != nil {
+			return nil, err
+		}
+
+		if created {
+			summary.MeetingsCreated++
+		}
+
+		if updated {
+			summary.MeetingsUpdated++
+		}
+	}
+
+	for _, race := range races {
+		created, updated, err := upsertRace(tx, race, audit, i.clock())
+		if err != nil {
+			return nil, err
+		}
+
+		if created {
+			summary.RacesCreated++
+		}
+
+		if updated {
+			summary.RacesUpdated++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+// exists reports whether a table holds a row with the given id.
+func exists(tx *sql.Tx, table string, id int64) (bool, error) {
+	var count int
+	err := tx.QueryRow(`SELECT COUNT(*) FROM `+table+` WHERE id = ?`, id).Scan(&count)
+
+	return count > 0, err
+}
+
+// venueIDExpr resolves the venue of an imported meeting, binding its venue_id and then its venue name.
+// Rows without a venue_id are matched to a venue by name, and meetings at unknown venues are stored with venue 0.
+const venueIDExpr = `COALESCE(NULLIF(?, 0), (SELECT id FROM venues WHERE name = ?), 0)`
+
+// upsertMeeting inserts a meeting or overwrites the stored one, reporting whether it was created or changed.
+func upsertMeeting(tx *sql.Tx, meeting *racing.Meeting) (bool, bool, error) {
+	found, err := exists(tx, "meetings", meeting.Id)
+	if err != nil {
+		return false, false, err
+	}
+
+	if !found {
+		_, err := tx.Exec(
+			`INSERT INTO meetings(id, venue, country, race_type, date, track_condition, venue_id) VALUES (?,?,?,?,?,?,`+venueIDExpr+`)`,
+			meeting.Id, meeting.Venue, meeting.Country, meeting.RaceType, meeting.Date, meeting.TrackCondition, meeting.VenueId, meeting.Venue,
+		)
+
+		return err == nil, false, err
+	}
+
+	result, err := tx.Exec(
+		`UPDATE meetings SET venue = ?, country = ?, race_type = ?, date = ?, track_condition = ?, venue_id = `+venueIDExpr+`
+		WHERE id = ? AND NOT (venue IS ? AND country IS ? AND race_type IS ? AND date IS ? AND track_condition IS ? AND venue_id IS `+venueIDExpr+`)`,
+		meeting.Venue, meeting.Country, meeting.RaceType, meeting.Date, meeting.TrackCondition, meeting.VenueId, meeting.Venue,
+		meeting.Id,
+		meeting.Venue, meeting.Country, meeting.RaceType, meeting.Date, meeting.TrackCondition, meeting.VenueId, meeting.Venue,
+	)
+	if err != nil {
+		return false, false, err
+	}
+
+	affected, err := result.RowsAffected()
+
+	return false, affected > 0, err
+}
+
+// upsertRace inserts an open race or overwrites the stored one's fields at the given time, reporting whether it was created or changed.
+// The status of a stored race is kept, as it only moves through status changes.
+func upsertRace(tx *sql.Tx, race *racing.Race, audit Audit, at time.Time) (bool, bool, error) {
+	found, err := exists(tx, "races", race.Id)
+	if err != nil {
+		return false, false, err
+	}
+
+	advertisedStart := race.AdvertisedStartTime.AsTime()
+
+	if !found {
+		// A race deleted earlier keeps its revision history, so a race imported under its id carries on from it.
+		_, err := tx.Exec(
+			`INSERT INTO races(id, meeting_id, name, number, visible, advertised_start_time, status, version)
+			VALUES (?,?,?,?,?,?,?,(SELECT IFNULL(MAX(revision), 0) + 1 FROM race_revisions WHERE race_id = ?))`,
+			race.Id, race.MeetingId, race.Name, race.Number, race.Visible, advertisedStart, racing.RaceStatus_RACE_STATUS_OPEN,
+			race.Id,
+		)
+		if err != nil {
+			return false, false, err
+		}
+
+		if err := appendRaceChange(tx, race.Id, racing.RaceChangeKind_RACE_CHANGE_KIND_CREATED, at); err != nil {
+			return false, false, err
+		}
+
+		return true, false, appendRaceRevision(tx, race.Id, racing.RaceChangeKind_RACE_CHANGE_KIND_CREATED, audit, at)
+	}
+
+	result, err := tx.Exec(
+		`UPDATE races SET meeting_id = ?, name = ?, number = ?, visible = ?, advertised_start_time = ?, version = version + 1
+		WHERE id = ? AND NOT (meeting_id IS ? AND name IS ? AND number IS ? AND visible IS ? AND advertised_start_time IS ?)`,
+		race.MeetingId, race.Name, race.Number, race.Visible, advertisedStart,
+		race.Id,
+		race.MeetingId, race.Name, race.Number, race.Visible, advertisedStart,
+	)
+	if err != nil {
+		return false, false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, false, err
+	}
+
+	if err := appendRaceChange(tx, race.Id, racing.RaceChangeKind_RACE_CHANGE_KIND_UPDATED, at); err != nil {
+		return false, false, err
+	}
+
+	return false, true, appendRaceRevision(tx, race.Id, racing.RaceChangeKind_RACE_CHANGE_KIND_UPDATED, audit, at)
+}
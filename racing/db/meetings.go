@@ -0,0 +1,145 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// ErrMeetingNotFound is returned when no meeting has the requested id.
+var ErrMeetingNotFound = errors.New("meeting not found")
+
+// MeetingsRepo provides repository access to race meetings.
+type MeetingsRepo interface {
+	// Init will initialise our meetings repository.
+	Init() error
+
+	// Migrate will create or upgrade the tables of our meetings repository without seeding dummy data.
+	Migrate() error
+
+	// List will return a list of meetings.
+	List(filter *racing.ListMeetingsRequestFilter) ([]*racing.Meeting, error)
+
+	// Get will return a meeting by id.
+	Get(id int64) (*racing.Meeting, error)
+}
+
+type meetingsRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// NewMeetingsRepo creates a new meetings repository.
+func NewMeetingsRepo(db *sql.DB) MeetingsRepo {
+	return &meetingsRepo{db: db}
+}
+
+// Init prepares the meetings repository table, dummy meetings are seeded along with the races by the Seeder.
+func (m *meetingsRepo) Init() error {
+	var err error
+
+	m.init.Do(func() {
+		err = m.Migrate()
+	})
+
+	return err
+}
+
+func (m *meetingsRepo) List(filter *racing.ListMeetingsRequestFilter) ([]*racing.Meeting, error) {
+	query, args := m.applyFilter(getMeetingQueries()[meetingsList], filter)
+
+	rows, err := m.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.scanMeetings(rows)
+}
+
+func (m *meetingsRepo) Get(id int64) (*racing.Meeting, error) {
+	rows, err := m.db.Query(getMeetingQueries()[meetingsList]+" WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	meetings, err := m.scanMeetings(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(meetings) == 0 {
+		return nil, ErrMeetingNotFound
+	}
+
+	return meetings[0], nil
+}
+
+func (m *meetingsRepo) applyFilter(query string, filter *racing.ListMeetingsRequestFilter) (string, []interface{}) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	if filter != nil {
+		if len(filter.Ids) > 0 {
+			clauses = append(clauses, "id IN ("+strings.Repeat("?,", len(filter.Ids)-1)+"?)")
+
+			for _, id := range filter.Ids {
+				args = append(args, id)
+			}
+		}
+
+		if len(filter.RaceTypes) > 0 {
+			clauses = append(clauses, "race_type IN ("+strings.Repeat("?,", len(filter.RaceTypes)-1)+"?)")
+
+			for _, raceType := range filter.RaceTypes {
+				args = append(args, raceType)
+			}
+		}
+
+		if filter.Country != "" {
+			clauses = append(clauses, "country = ?")
+			args = append(args, strings.ToUpper(filter.Country))
+		}
+
+		if filter.Date != "" {
+			clauses = append(clauses, "date = ?")
+			args = append(args, filter.Date)
+		}
+
+		if len(filter.VenueIds) > 0 {
+			clauses = append(clauses, "venue_id IN ("+strings.Repeat("?,", len(filter.VenueIds)-1)+"?)")
+
+			for _, venueID := range filter.VenueIds {
+				args = append(args, venueID)
+			}
+		}
+	}
+
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	return query + " ORDER BY date, venue, id", args
+}
+
+func (m *meetingsRepo) scanMeetings(rows *sql.Rows) ([]*racing.Meeting, error) {
+	defer rows.Close()
+
+	var meetings []*racing.Meeting
+
+	for rows.Next() {
+		var meeting racing.Meeting
+
+		if err := rows.Scan(&meeting.Id, &meeting.Venue, &meeting.Country, &meeting.RaceType, &meeting.Date, &meeting.TrackCondition, &meeting.VenueId); err != nil {
+			return nil, err
+		}
+
+		meetings = append(meetings, &meeting)
+	}
+
+	return meetings, rows.Err()
+}
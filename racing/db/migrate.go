@@ -0,0 +1,119 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+)
+
+// addColumn adds a column to an existing table when it is missing, reporting whether it was added.
+// CREATE TABLE IF NOT EXISTS leaves databases created by older versions untouched, so new columns go through here.
+func addColumn(db *sql.DB, table, column, definition string) (bool, error) {
+	rows, err := db.Query(`SELECT name FROM pragma_table_info(?)`, table)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return false, err
+		}
+
+		if name == column {
+			return false, nil
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+
+	if _, err := db.Exec(`ALTER TABLE ` + table + ` ADD COLUMN ` + column + ` ` + definition); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// normaliseTimes rewrites the given time columns of a table in UTC, in the format the driver binds time.Time values with.
+// Times stored with different offsets don't compare correctly as text, so range filters rely on every row sharing one.
+func normaliseTimes(db *sql.DB, table string, columns ...string) error {
+	for _, column := range columns {
+		rows, err := db.Query(`SELECT id, ` + column + ` FROM ` + table + ` WHERE ` + column + ` NOT LIKE '%+00:00'`)
+		if err != nil {
+			return err
+		}
+
+		stored := make(map[int64]time.Time)
+		for rows.Next() {
+			var (
+				id int64
+				t  time.Time
+			)
+
+			if err := rows.Scan(&id, &t); err != nil {
+				rows.Close()
+				return err
+			}
+
+			stored[id] = t
+		}
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for id, t := range stored {
+			if _, err := db.Exec(`UPDATE `+table+` SET `+column+` = ? WHERE id = ?`, t.UTC(), id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isEmpty reports whether a table holds no rows.
+func isEmpty(db *sql.DB, table string) (bool, error) {
+	var exists bool
+	if err := db.QueryRow(`SELECT EXISTS (SELECT 1 FROM ` + table + `)`).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return !exists, nil
+}
+
+// hasTable reports whether a table has been created.
+func hasTable(db *sql.DB, table string) (bool, error) {
+	var exists bool
+	err := db.QueryRow(`SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = ?)`, table).Scan(&exists)
+
+	return exists, err
+}
+
+// revisedRaces returns the ids of the races with a revision history, an empty set when the history isn't kept yet.
+func revisedRaces(db *sql.DB) (map[int64]bool, error) {
+	revised := make(map[int64]bool)
+
+	if kept, err := hasTable(db, "race_revisions"); err != nil || !kept {
+		return revised, err
+	}
+
+	rows, err := db.Query(`SELECT DISTINCT race_id FROM race_revisions`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		revised[id] = true
+	}
+
+	return revised, rows.Err()
+}
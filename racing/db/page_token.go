@@ -0,0 +1,154 @@
+package db
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxPageSize caps the number of rows a single page can return.
+	maxPageSize = 1000
+)
+
+// ErrInvalidPageToken is returned when a page token can't be decoded or was issued for a different filter.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// pageToken is the cursor encoded into next_page_token.
+// It stores the sort key values of the last row served, so following pages are anchored on a position in the
+// ordering rather than an offset, which keeps them stable when rows are inserted, and carries on when the last
+// row served is deleted. Relevance isn't a stored value, so pages ordered by it aren't kept stable.
+type pageToken struct {
+	Values []sortValue `json:"v"`
+	Filter string      `json:"f"`
+}
+
+// sortValue is a sort key value of the last row served, typed so that it binds as the column stores it.
+// A NULL value leaves every field unset.
+type sortValue struct {
+	Int   *int64     `json:"i,omitempty"`
+	Float *float64   `json:"r,omitempty"`
+	Text  *string    `json:"s,omitempty"`
+	Time  *time.Time `json:"t,omitempty"`
+}
+
+// newSortValue wraps a value scanned from the database.
+func newSortValue(v interface{}) sortValue {
+	switch v := v.(type) {
+	case int64:
+		return sortValue{Int: &v}
+	case float64:
+		return sortValue{Float: &v}
+	case bool:
+		i := int64(0)
+		if v {
+			i = 1
+		}
+		return sortValue{Int: &i}
+	case string:
+		return sortValue{Text: &v}
+	case []byte:
+		s := string(v)
+		return sortValue{Text: &s}
+	case time.Time:
+		t := v.UTC()
+		return sortValue{Time: &t}
+	}
+
+	return sortValue{}
+}
+
+// arg returns the value to bind.
+func (v sortValue) arg() interface{} {
+	switch {
+	case v.Int != nil:
+		return *v.Int
+	case v.Float != nil:
+		return *v.Float
+	case v.Text != nil:
+		return *v.Text
+	case v.Time != nil:
+		return v.Time.UTC()
+	}
+
+	return nil
+}
+
+// encodePageToken returns an opaque token pointing after the row holding the given sort key values.
+func encodePageToken(values []interface{}, filter proto.Message) (string, error) {
+	fingerprint, err := filterFingerprint(filter)
+	if err != nil {
+		return "", err
+	}
+
+	token := pageToken{Filter: fingerprint}
+	for _, v := range values {
+		token.Values = append(token.Values, newSortValue(v))
+	}
+
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decodePageToken parses a token produced by encodePageToken, an empty token returns nil.
+func decodePageToken(token string, filter proto.Message) (*pageToken, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var pt pageToken
+	if err := json.Unmarshal(raw, &pt); err != nil || len(pt.Values) == 0 {
+		return nil, ErrInvalidPageToken
+	}
+
+	fingerprint, err := filterFingerprint(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// A token only makes sense for the filter it was issued with.
+	if pt.Filter != fingerprint {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &pt, nil
+}
+
+// filterFingerprint returns a short stable hash of the filter.
+func filterFingerprint(filter proto.Message) (string, error) {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(raw)
+
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// pageLimit normalises a requested page size, zero means no limit.
+func pageLimit(pageSize int32) int {
+	if pageSize <= 0 {
+		return 0
+	}
+
+	if pageSize > maxPageSize {
+		return maxPageSize
+	}
+
+	return int(pageSize)
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// PricesRepo provides repository access to runner prices.
+type PricesRepo interface {
+	// Init will initialise our prices repository.
+	Init() error
+
+	// Migrate will create or upgrade the tables of our prices repository without seeding dummy data.
+	Migrate() error
+
+	// Current will return the latest price of each runner in a race.
+	Current(raceID int64) ([]*racing.RunnerPrice, error)
+
+	// History will return every price of the runners in a race, limited to runnerIDs when it isn't empty.
+	History(raceID int64, runnerIDs []int64) ([]*racing.RunnerPrice, error)
+
+	// Update will append new prices for runners in a race, set now.
+	Update(raceID int64, prices []*racing.RunnerPrice) error
+}
+
+type pricesRepo struct {
+	db    *sql.DB
+	clock Clock
+	init  sync.Once
+}
+
+// NewPricesRepo creates a new prices repository, reading the current time from clock.
+func NewPricesRepo(db *sql.DB, clock Clock) PricesRepo {
+	return &pricesRepo{db: db, clock: clock}
+}
+
+// Init prepares the prices repository table, dummy prices are seeded along with the races by the Seeder.
+func (p *pricesRepo) Init() error {
+	var err error
+
+	p.init.Do(func() {
+		err = p.Migrate()
+	})
+
+	return err
+}
+
+func (p *pricesRepo) Current(raceID int64) ([]*racing.RunnerPrice, error) {
+	// Prices are only ever appended, so the highest id of a runner is its latest price.
+	rows, err := p.db.Query(getPriceQueries()[pricesList]+` WHERE id IN (SELECT MAX(id) FROM runner_prices WHERE race_id = ? GROUP BY runner_id) ORDER BY runner_id`, raceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.scanPrices(rows)
+}
+
+func (p *pricesRepo) History(raceID int64, runnerIDs []int64) ([]*racing.RunnerPrice, error) {
+	query := getPriceQueries()[pricesList] + " WHERE race_id = ?"
+	args := []interface{}{raceID}
+
+	if len(runnerIDs) > 0 {
+		query += " AND runner_id IN (" + strings.Repeat("?,", len(runnerIDs)-1) + "?)"
+
+		for _, runnerID := range runnerIDs {
+			args = append(args, runnerID)
+		}
+	}
+
+	rows, err := p.db.Query(query+" ORDER BY runner_id, id", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.scanPrices(rows)
+}
+
+func (p *pricesRepo) Update(raceID int64, prices []*racing.RunnerPrice) error {
+	at := p.clock()
+
+	tx, err := p.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, price := range prices {
+		if _, err := tx.Exec(`INSERT INTO runner_prices(race_id, runner_id, win, place, updated_time) VALUES (?,?,?,?,?)`, raceID, price.RunnerId, price.Win, price.Place, at.UTC()); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (p *pricesRepo) scanPrices(rows *sql.Rows) ([]*racing.RunnerPrice, error) {
+	defer rows.Close()
+
+	var prices []*racing.RunnerPrice
+
+	for rows.Next() {
+		var (
+			price       racing.RunnerPrice
+			updatedTime time.Time
+		)
+
+		if err := rows.Scan(&price.RunnerId, &price.Win, &price.Place, &updatedTime); err != nil {
+			return nil, err
+		}
+
+		price.UpdatedTime = timestamppb.New(updatedTime)
+		prices = append(prices, &price)
+	}
+
+	return prices, rows.Err()
+}
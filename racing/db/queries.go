@@ -1,7 +1,16 @@
 package db
 
+import "strings"
+
 const (
-	racesList = "list"
+	racesList     = "list"
+	meetingsList  = "list"
+	runnersList   = "list"
+	resultsList   = "list"
+	placingsList  = "placings"
+	pricesList    = "list"
+	changesList   = "list"
+	revisionsList = "list"
 )
 
 func getRaceQueries() map[string]string {
@@ -13,8 +22,120 @@ func getRaceQueries() map[string]string {
 				name, 
 				number, 
 				visible, 
-				advertised_start_time 
+				advertised_start_time,
+				status,
+				version
 			FROM races
 		`,
 	}
 }
+
+// raceColumns are the columns selected by the races list query, in select order.
+var raceColumns = []string{"id", "meeting_id", "name", "number", "visible", "advertised_start_time", "status", "version"}
+
+// getRaceColumnsQuery returns the races list query narrowed to the given columns.
+func getRaceColumnsQuery(columns []string) string {
+	return `SELECT ` + strings.Join(columns, ", ") + ` FROM races`
+}
+
+func getMeetingQueries() map[string]string {
+	return map[string]string{
+		meetingsList: `
+			SELECT
+				id,
+				venue,
+				country,
+				race_type,
+				date,
+				track_condition,
+				venue_id
+			FROM meetings
+		`,
+	}
+}
+
+func getRunnerQueries() map[string]string {
+	return map[string]string{
+		runnersList: `
+			SELECT
+				id,
+				race_id,
+				saddle_number,
+				barrier,
+				name,
+				jockey,
+				trainer,
+				weight,
+				scratched,
+				scratch_time
+			FROM runners
+		`,
+	}
+}
+
+func getResultQueries() map[string]string {
+	return map[string]string{
+		resultsList: `
+			SELECT
+				race_id,
+				protest_status,
+				recorded_time
+			FROM race_results
+		`,
+		placingsList: `
+			SELECT
+				race_id,
+				runner_id,
+				position,
+				margin
+			FROM race_placings
+		`,
+	}
+}
+
+func getPriceQueries() map[string]string {
+	return map[string]string{
+		pricesList: `
+			SELECT
+				runner_id,
+				win,
+				place,
+				updated_time
+			FROM runner_prices
+		`,
+	}
+}
+
+func getChangeQueries() map[string]string {
+	return map[string]string{
+		changesList: `
+			SELECT
+				sequence,
+				race_id,
+				kind,
+				change_time
+			FROM race_changes
+		`,
+	}
+}
+
+func getRevisionQueries() map[string]string {
+	return map[string]string{
+		revisionsList: `
+			SELECT
+				race_id,
+				revision,
+				kind,
+				actor,
+				reason,
+				change_time,
+				meeting_id,
+				name,
+				number,
+				visible,
+				advertised_start_time,
+				status
+			FROM race_revisions
+		`,
+	}
+}
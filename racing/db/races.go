@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -10,183 +11,754 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"git.neds.sh/matty/entain/racing/proto/racing"
 )
 
+// ErrEtagMismatch is returned when a race was written since the etag passed with a change was read.
+var ErrEtagMismatch = errors.New("etag mismatch")
+
 // RacesRepo provides repository access to races.
 type RacesRepo interface {
 	// Init will initialise our races repository.
 	Init() error
 
-	// List will return a list of races.
-	List(filter *racing.ListRacesRequestFilter) ([]*racing.Race, error)
+	// Migrate will create or upgrade the tables of our races repository without seeding dummy data.
+	Migrate() error
+
+	// List will return a page of races and the token for the following page.
+	List(req *racing.ListRacesRequest) ([]*racing.Race, string, error)
+
+	// NextToGo will return up to limit visible races that haven't started by asOf, soonest first.
+	// A nil asOf means the current time.
+	NextToGo(filter *racing.ListNextToGoRequestFilter, limit int, asOf *timestamppb.Timestamp) ([]*racing.Race, error)
 
-	// GetRace will return a race collection by id
+	// GetRace will return a race by id, or ErrRaceNotFound when it doesn't exist.
 	GetRace(req *racing.GetRaceRequest) (*racing.Race, error)
+
+	// BatchGet will return the races with the given ids keyed by id, ids that don't exist are left out.
+	BatchGet(ids []int64, mask *fieldmaskpb.FieldMask) (map[int64]*racing.Race, error)
+
+	// SetStatus will move a race to a new status and return the updated race.
+	SetStatus(id int64, status racing.RaceStatus, audit Audit) (*racing.Race, error)
+
+	// Create will store a new open race and return it.
+	Create(race *racing.Race, audit Audit) (*racing.Race, error)
+
+	// Update will write the given columns of a race and return the updated race.
+	// A non zero version must match the stored one, otherwise ErrEtagMismatch is returned.
+	Update(race *racing.Race, columns []string, version int64, audit Audit) (*racing.Race, error)
+
+	// Delete will remove a race with its runners, result and prices, its revision history is kept.
+	// A non zero version must match the stored one, otherwise ErrEtagMismatch is returned.
+	Delete(id int64, version int64, audit Audit) error
+
+	// CloseStarted will store open races whose advertised start time has passed as closed, returning how many were closed.
+	CloseStarted() (int, error)
 }
 
 type racesRepo struct {
-	db   *sql.DB
-	init sync.Once
+	db    *sql.DB
+	clock Clock
+	init  sync.Once
 }
 
-// NewRacesRepo creates a new races repository.
-func NewRacesRepo(db *sql.DB) RacesRepo {
-	return &racesRepo{db: db}
+// NewRacesRepo creates a new races repository, reading the current time from clock.
+func NewRacesRepo(db *sql.DB, clock Clock) RacesRepo {
+	return &racesRepo{db: db, clock: clock}
 }
 
-// Init prepares the race repository dummy data.
+// Init prepares the race repository tables, dummy races are seeded by the Seeder.
 func (r *racesRepo) Init() error {
 	var err error
 
 	r.init.Do(func() {
-		// For test/example purposes, we seed the DB with some dummy races.
-		err = r.seed()
+		err = r.Migrate()
 	})
 
 	return err
 }
 
-func (r *racesRepo) List(filter *racing.ListRacesRequestFilter) ([]*racing.Race, error) {
+func (r *racesRepo) List(req *racing.ListRacesRequest) ([]*racing.Race, string, error) {
 	var (
 		err   error
 		query string
 		args  []interface{}
 	)
 
-	query = getRaceQueries()[racesList]
-	query, args = r.applyFilter(query, filter)
+	token, err := decodePageToken(req.PageToken, req.Filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	keys, match, err := raceOrdering(req.Filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The sort key values are selected after the race columns, so the next page token is built from the rows read.
+	columns := readColumns(req.ReadMask)
+	selected := append([]string{}, columns...)
+	for _, key := range keys {
+		selected = append(selected, key.column)
+	}
+
+	query = getRaceColumnsQuery(selected)
+	query, args, err = r.applyFilter(query, req.Filter, keys, match, token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Fetch one extra row to find out whether another page follows.
+	limit := pageLimit(req.PageSize)
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit+1)
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	races, values, err := r.scanRacePage(rows, columns, len(keys), r.clock.at(req.AsOf))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if req.AsOf != nil {
+		if err := r.replayAt(races, req.AsOf.AsTime()); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if limit == 0 || len(races) <= limit {
+		return races, "", nil
+	}
+
+	races = races[:limit]
+	nextPageToken, err := encodePageToken(values[limit-1], req.Filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return races, nextPageToken, nil
+}
+
+func (r *racesRepo) NextToGo(filter *racing.ListNextToGoRequestFilter, limit int, asOf *timestamppb.Timestamp) ([]*racing.Race, error) {
+	now := r.clock.at(asOf)
+
+	// A past instant is replayed from the revision history, so races are next to go as they stood then.
+	status, advertisedStart := "status", "advertised_start_time"
+	var statusArgs, advertisedStartArgs []interface{}
+	if asOf != nil {
+		status, advertisedStart = statusAtExpr, advertisedStartAtExpr
+		statusArgs, advertisedStartArgs = []interface{}{now.UTC()}, []interface{}{now.UTC()}
+	}
+
+	// Only races that can still be bet on are next to go, so closed or settled races are left out
+	// even when their advertised start time was pushed back.
+	clauses := []string{"visible = 1", advertisedStart + " > ?", status + " IN (?,?)"}
+	args := append(append(advertisedStartArgs, now.UTC()), statusArgs...)
+	args = append(args, racing.RaceStatus_RACE_STATUS_OPEN, racing.RaceStatus_RACE_STATUS_SUSPENDED)
+
+	if len(filter.GetMeetingIds()) > 0 {
+		clauses = append(clauses, "meeting_id IN ("+strings.Repeat("?,", len(filter.MeetingIds)-1)+"?)")
+
+		for _, meetingID := range filter.MeetingIds {
+			args = append(args, meetingID)
+		}
+	}
+
+	if len(filter.GetRaceTypes()) > 0 {
+		clauses = append(clauses, "meeting_id IN (SELECT id FROM meetings WHERE race_type IN ("+strings.Repeat("?,", len(filter.RaceTypes)-1)+"?))")
+
+		for _, raceType := range filter.RaceTypes {
+			args = append(args, raceType)
+		}
+	}
+
+	query := getRaceQueries()[racesList] + " WHERE " + strings.Join(clauses, " AND ") + " ORDER BY " + advertisedStart + " ASC, id ASC LIMIT ?"
+	args = append(append(args, advertisedStartArgs...), limit)
 
 	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.scanRaces(rows)
+	races, err := r.scanRaces(rows, raceColumns, now)
+	if err != nil || asOf == nil {
+		return races, err
+	}
+
+	return races, r.replayAt(races, now)
 }
 
-func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFilter) (string, []interface{}) {
+// raceOrdering returns the keys races are listed in and, when the filter searches, the FTS5 query matching them.
+// Search results without a sort spec are ordered by relevance, then id.
+// Relevance is scored against every indexed race, so it shifts as races are written and pages ordered by it
+// can skip or repeat races written to between requests.
+func raceOrdering(filter *racing.ListRacesRequestFilter) ([]sortKey, string, error) {
+	keys, err := raceSortKeys(filter.GetSort())
+	if err != nil {
+		return nil, "", err
+	}
+
+	text := strings.TrimSpace(filter.GetQuery())
+	if text == "" {
+		return keys, "", nil
+	}
+
+	match, err := matchQuery(text)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(filter.GetSort()) == 0 {
+		keys = append([]sortKey{{column: "rank"}}, keys...)
+	}
+
+	return keys, match, nil
+}
+
+// searchRaces narrows a races query to the races matching an FTS5 query.
+// Matches are joined rather than filtered with IN, so their rank can order races by relevance.
+func searchRaces(query, match string) (string, []interface{}) {
+	return "WITH matches AS (SELECT rowid AS race_id, rank FROM races_fts WHERE races_fts MATCH ?)" + query + " JOIN matches ON race_id = id",
+		[]interface{}{match}
+}
+
+// applyFilter narrows a races query to the filter, ordered by keys and searched with the FTS5 match when it is set.
+func (r *racesRepo) applyFilter(query string, filter *racing.ListRacesRequestFilter, keys []sortKey, match string, token *pageToken) (string, []interface{}, error) {
 	var (
 		clauses []string
 		args    []interface{}
 	)
 
-	if filter == nil {
-		return query, args
+	if match != "" {
+		query, args = searchRaces(query, match)
 	}
 
-	if len(filter.MeetingIds) > 0 {
-		clauses = append(clauses, "meeting_id IN ("+strings.Repeat("?,", len(filter.MeetingIds)-1)+"?)")
-
-		for _, meetingID := range filter.MeetingIds {
-			args = append(args, meetingID)
+	// Resume after the row the page token points at, using the same ordering as the query.
+	if token != nil {
+		if len(token.Values) != len(keys) {
+			return "", nil, ErrInvalidPageToken
 		}
+
+		clause, seekArgs := seekAfter(keys, token.Values)
+		clauses = append(clauses, clause)
+		args = append(args, seekArgs...)
 	}
 
-	if filter.Visible == true {
+	if filter != nil {
 		if len(filter.MeetingIds) > 0 {
-			clauses = append(clauses, "and visible = 1")
-		} else {
-			clauses = append(clauses, " visible = 1")
+			clauses = append(clauses, "meeting_id IN ("+strings.Repeat("?,", len(filter.MeetingIds)-1)+"?)")
+
+			for _, meetingID := range filter.MeetingIds {
+				args = append(args, meetingID)
+			}
+		}
+
+		if clause := visibilityClause(FilterVisibility(filter)); clause != "" {
+			clauses = append(clauses, clause)
 		}
-	}
 
-	if len(clauses) != 0 && len(filter.Column) == 0 && len(filter.OrderBy) == 0 {
-		query += " WHERE " + strings.Join(clauses, " ")
+		// Stored times are all UTC, so they compare as text against UTC bounds.
+		if from := filter.GetAdvertisedStartFrom(); from != nil {
+			clauses = append(clauses, "advertised_start_time >= ?")
+			args = append(args, from.AsTime())
+		}
+
+		if to := filter.GetAdvertisedStartTo(); to != nil {
+			clauses = append(clauses, "advertised_start_time < ?")
+			args = append(args, to.AsTime())
+		}
 	}
 
-	if len(filter.Column) > 0 && len(filter.OrderBy) > 0 {
-		clauses = append(clauses, "ORDER BY "+filter.Column+" "+filter.OrderBy)
-		query += " WHERE " + strings.Join(clauses, " ")
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
 	}
 
-	// check query works or not
-	// fmt.Println(query)
-	return query, args
+	return query + orderBy(keys), args, nil
 }
 
-func (r *racesRepo) scanRaces(rows *sql.Rows) ([]*racing.Race, error) {
-	var races []*racing.Race
+// scanRaces scans rows holding the given race columns, fields whose column wasn't selected are left unset.
+// Derived fields are evaluated at now.
+func (r *racesRepo) scanRaces(rows *sql.Rows, columns []string, now time.Time) ([]*racing.Race, error) {
+	races, _, err := r.scanRacePage(rows, columns, 0, now)
+
+	return races, err
+}
+
+// scanRacePage scans rows holding the given race columns followed by the values of sortKeys sort keys,
+// returning the sort key values of each race alongside it.
+func (r *racesRepo) scanRacePage(rows *sql.Rows, columns []string, sortKeys int, now time.Time) ([]*racing.Race, [][]interface{}, error) {
+	var (
+		races  []*racing.Race
+		values [][]interface{}
+	)
 
 	for rows.Next() {
-		var race racing.Race
-		var advertisedStart time.Time
+		var (
+			race            racing.Race
+			advertisedStart time.Time
+			version         int64
+			selected        = make(map[string]bool, len(columns))
+			dest            = make([]interface{}, len(columns), len(columns)+sortKeys)
+			keyValues       = make([]interface{}, sortKeys)
+		)
+
+		for i, column := range columns {
+			selected[column] = true
+
+			switch column {
+			case "id":
+				dest[i] = &race.Id
+			case "meeting_id":
+				dest[i] = &race.MeetingId
+			case "name":
+				dest[i] = &race.Name
+			case "number":
+				dest[i] = &race.Number
+			case "visible":
+				dest[i] = &race.Visible
+			case "advertised_start_time":
+				dest[i] = &advertisedStart
+			case "status":
+				dest[i] = &race.Status
+			case "version":
+				dest[i] = &version
+			}
+		}
+
+		for i := range keyValues {
+			dest = append(dest, &keyValues[i])
+		}
 
-		if err := rows.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart); err != nil {
+		if err := rows.Scan(dest...); err != nil {
 			// replace "err == sql.ErrNoRows" to errors.Is, it is a built-in function.
 			if errors.Is(err, sql.ErrNoRows) {
-				return nil, nil
+				return nil, nil, nil
 			}
 
-			return nil, err
+			return nil, nil, err
 		}
 
-		ts, err := ptypes.TimestampProto(advertisedStart)
-		if err != nil {
-			return nil, err
+		if selected["advertised_start_time"] {
+			ts, err := ptypes.TimestampProto(advertisedStart)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			race.AdvertisedStartTime = ts
 		}
 
-		race.AdvertisedStartTime = ts
+		if selected["status"] {
+			race.Status = effectiveStatus(race.Status, advertisedStart, now)
+		}
 
-		if time.Now().Unix() > ts.AsTime().Unix() {
-			race.Status = "CLOSED"
-		} else {
-			race.Status = "OPEN"
+		if selected["version"] {
+			race.Etag = strconv.FormatInt(version, 10)
 		}
 
 		races = append(races, &race)
+		values = append(values, keyValues)
 	}
 
-	return races, nil
+	return races, values, nil
+}
+
+// readColumns returns the race columns needed to populate the fields of a read mask, every column when it is empty.
+// id is always selected as pages and embedded resources are keyed on it, and the reported status depends on the start time.
+func readColumns(mask *fieldmaskpb.FieldMask) []string {
+	if len(mask.GetPaths()) == 0 {
+		return raceColumns
+	}
+
+	needed := map[string]bool{"id": true}
+	for _, path := range mask.GetPaths() {
+		field := strings.SplitN(path, ".", 2)[0]
+		needed[field] = true
+
+		switch field {
+		case "status":
+			needed["advertised_start_time"] = true
+		case "meeting":
+			needed["meeting_id"] = true
+		case "local_advertised_start_time":
+			needed["advertised_start_time"] = true
+			needed["meeting_id"] = true
+		case "etag":
+			needed["version"] = true
+		}
+	}
+
+	var columns []string
+	for _, column := range raceColumns {
+		if needed[column] {
+			columns = append(columns, column)
+		}
+	}
+
+	return columns
 }
 
 func (r *racesRepo) GetRace(req *racing.GetRaceRequest) (*racing.Race, error) {
+	columns := readColumns(req.ReadMask)
+	query := getRaceColumnsQuery(columns) + " WHERE id = ?"
+
+	rows, err := r.db.Query(query, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	races, err := r.scanRaces(rows, columns, r.clock.at(req.AsOf))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(races) == 0 {
+		return nil, ErrRaceNotFound
+	}
+
+	if req.AsOf != nil {
+		if err := r.replayAt(races, req.AsOf.AsTime()); err != nil {
+			return nil, err
+		}
+	}
+
+	return races[0], nil
+}
+
+func (r *racesRepo) BatchGet(ids []int64, mask *fieldmaskpb.FieldMask) (map[int64]*racing.Race, error) {
+	races := make(map[int64]*racing.Race, len(ids))
+	if len(ids) == 0 {
+		return races, nil
+	}
+
+	columns := readColumns(mask)
+	query := getRaceColumnsQuery(columns) + " WHERE id IN (" + strings.Repeat("?,", len(ids)-1) + "?)"
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := r.scanRaces(rows, columns, r.clock())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, race := range found {
+		races[race.Id] = race
+	}
+
+	return races, nil
+}
+
+func (r *racesRepo) SetStatus(id int64, status racing.RaceStatus, audit Audit) (*racing.Race, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	var (
-		err   error
-		query string
+		stored          racing.RaceStatus
+		advertisedStart time.Time
 	)
 
-	query = getRaceQueries()[racesList]
+	err = tx.QueryRow(`SELECT status, advertised_start_time FROM races WHERE id = ?`, id).Scan(&stored, &advertisedStart)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRaceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	current := effectiveStatus(stored, advertisedStart, r.clock())
+	if !canTransition(current, status) {
+		return nil, fmt.Errorf("%w: %v to %v", ErrIllegalStatusTransition, current, status)
+	}
+
+	// The stored status guards the update, so a concurrent change can't skip a transition.
+	result, err := tx.Exec(`UPDATE races SET status = ?, version = version + 1 WHERE id = ? AND status = ?`, status, id, stored)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, fmt.Errorf("%w: race %d was changed concurrently", ErrIllegalStatusTransition, id)
+	}
+
+	if err := appendRaceChange(tx, id, racing.RaceChangeKind_RACE_CHANGE_KIND_STATUS_CHANGED, r.clock()); err != nil {
+		return nil, err
+	}
+
+	if err := appendRaceRevision(tx, id, racing.RaceChangeKind_RACE_CHANGE_KIND_STATUS_CHANGED, audit, r.clock()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetRace(&racing.GetRaceRequest{Id: id})
+}
 
-	if req.Id > 0 {
-		id := strconv.Itoa(int(req.Id))
-		query += " WHERE id=" + id
+func (r *racesRepo) Create(race *racing.Race, audit Audit) (*racing.Race, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// The ids of deleted races live on in their revision history, so they are never handed out again.
+	result, err := tx.Exec(
+		`INSERT INTO races(id, meeting_id, name, number, visible, advertised_start_time, status, version)
+		VALUES ((SELECT IFNULL(MAX(id), 0) + 1 FROM (SELECT id FROM races UNION ALL SELECT race_id FROM race_revisions)),?,?,?,?,?,?,1)`,
+		race.MeetingId,
+		race.Name,
+		race.Number,
+		race.Visible,
+		race.AdvertisedStartTime.AsTime(),
+		racing.RaceStatus_RACE_STATUS_OPEN,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	row, err := r.db.Query(query)
+	id, err := result.LastInsertId()
 	if err != nil {
 		return nil, err
 	}
 
-	return r.scanRace(row)
+	if err := appendRaceChange(tx, id, racing.RaceChangeKind_RACE_CHANGE_KIND_CREATED, r.clock()); err != nil {
+		return nil, err
+	}
+
+	if err := appendRaceRevision(tx, id, racing.RaceChangeKind_RACE_CHANGE_KIND_CREATED, audit, r.clock()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetRace(&racing.GetRaceRequest{Id: id})
 }
 
-func (r *racesRepo) scanRace(row *sql.Rows) (*racing.Race, error) {
-	var advertisedStart time.Time
-	race := &racing.Race{}
+func (r *racesRepo) Update(race *racing.Race, columns []string, version int64, audit Audit) (*racing.Race, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 
-	for row.Next() {
-		if err := row.Scan(&race.Id, &race.MeetingId, &race.Name, &race.Number, &race.Visible, &advertisedStart); err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				return nil, nil
-			}
+	stored, err := storedVersion(tx, race.Id, version)
+	if err != nil {
+		return nil, err
+	}
 
-			return nil, err
+	var (
+		assignments []string
+		args        []interface{}
+	)
+
+	for _, column := range columns {
+		assignments = append(assignments, column+" = ?")
+
+		switch column {
+		case "meeting_id":
+			args = append(args, race.MeetingId)
+		case "name":
+			args = append(args, race.Name)
+		case "number":
+			args = append(args, race.Number)
+		case "visible":
+			args = append(args, race.Visible)
+		case "advertised_start_time":
+			args = append(args, race.AdvertisedStartTime.AsTime())
+		default:
+			return nil, fmt.Errorf("race column %q can't be updated", column)
+		}
+	}
+
+	// The stored version guards the update, so a concurrent write isn't overwritten.
+	query := `UPDATE races SET ` + strings.Join(append(assignments, "version = version + 1"), ", ") + ` WHERE id = ? AND version = ?`
+	result, err := tx.Exec(query, append(args, race.Id, stored)...)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, fmt.Errorf("%w: race %d was changed concurrently", ErrEtagMismatch, race.Id)
+	}
+
+	if err := appendRaceChange(tx, race.Id, racing.RaceChangeKind_RACE_CHANGE_KIND_UPDATED, r.clock()); err != nil {
+		return nil, err
+	}
+
+	if err := appendRaceRevision(tx, race.Id, racing.RaceChangeKind_RACE_CHANGE_KIND_UPDATED, audit, r.clock()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetRace(&racing.GetRaceRequest{Id: race.Id})
+}
+
+func (r *racesRepo) Delete(id int64, version int64, audit Audit) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := storedVersion(tx, id, version); err != nil {
+		return err
+	}
+
+	// Deleting is a write like any other, so it is recorded as the race's next revision before the race is gone.
+	if _, err := tx.Exec(`UPDATE races SET version = version + 1 WHERE id = ?`, id); err != nil {
+		return err
+	}
+
+	if err := appendRaceRevision(tx, id, racing.RaceChangeKind_RACE_CHANGE_KIND_DELETED, audit, r.clock()); err != nil {
+		return err
+	}
+
+	statements := []string{
+		`DELETE FROM runner_prices WHERE race_id = ?`,
+		`DELETE FROM race_placings WHERE race_id = ?`,
+		`DELETE FROM race_results WHERE race_id = ?`,
+		`DELETE FROM runners WHERE race_id = ?`,
+		`DELETE FROM races WHERE id = ?`,
+	}
+
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement, id); err != nil {
+			return err
+		}
+	}
+
+	if err := appendRaceChange(tx, id, racing.RaceChangeKind_RACE_CHANGE_KIND_DELETED, r.clock()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// storedVersion returns the version of a race, checking it against the expected version unless that is zero.
+func storedVersion(tx *sql.Tx, id int64, expected int64) (int64, error) {
+	var version int64
+
+	err := tx.QueryRow(`SELECT version FROM races WHERE id = ?`, id).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrRaceNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if expected != 0 && expected != version {
+		return 0, fmt.Errorf("%w: race %d is at version %d", ErrEtagMismatch, id, version)
+	}
+
+	return version, nil
+}
+
+func (r *racesRepo) CloseStarted() (int, error) {
+	now := r.clock()
+
+	rows, err := r.db.Query(`SELECT id, advertised_start_time FROM races WHERE status = ?`, racing.RaceStatus_RACE_STATUS_OPEN)
+	if err != nil {
+		return 0, err
+	}
+
+	var started []int64
+	for rows.Next() {
+		var (
+			id              int64
+			advertisedStart time.Time
+		)
+
+		if err := rows.Scan(&id, &advertisedStart); err != nil {
+			rows.Close()
+			return 0, err
+		}
+
+		if effectiveStatus(racing.RaceStatus_RACE_STATUS_OPEN, advertisedStart, now) == racing.RaceStatus_RACE_STATUS_CLOSED {
+			started = append(started, id)
 		}
+	}
 
-		ts, err := ptypes.TimestampProto(advertisedStart)
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for _, id := range started {
+		ok, err := r.closeStarted(id)
 		if err != nil {
-			return nil, err
+			return closed, err
 		}
 
-		race.AdvertisedStartTime = ts
-		if time.Now().Unix() > ts.AsTime().Unix() {
-			race.Status = "CLOSED"
-		} else {
-			race.Status = "OPEN"
+		if ok {
+			closed++
 		}
 	}
 
-	return race, nil
+	return closed, nil
+}
+
+// closeStarted stores a started race as closed, reporting whether it was still open.
+// Another caller may have closed the race first, only the one that updates it logs the change.
+func (r *racesRepo) closeStarted(id int64) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`UPDATE races SET status = ?, version = version + 1 WHERE id = ? AND status = ?`, racing.RaceStatus_RACE_STATUS_CLOSED, id, racing.RaceStatus_RACE_STATUS_OPEN)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+
+	if err := appendRaceChange(tx, id, racing.RaceChangeKind_RACE_CHANGE_KIND_STATUS_CHANGED, r.clock()); err != nil {
+		return false, err
+	}
+
+	audit := systemAudit
+	audit.Reason = "advertised start time passed"
+	if err := appendRaceRevision(tx, id, racing.RaceChangeKind_RACE_CHANGE_KIND_STATUS_CHANGED, audit, r.clock()); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
 }
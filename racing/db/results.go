@@ -0,0 +1,224 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// ErrResultNotFound is returned when no result has been recorded for a race.
+var ErrResultNotFound = errors.New("race result not found")
+
+// ResultsRepo provides repository access to race results.
+type ResultsRepo interface {
+	// Init will initialise our results repository.
+	Init() error
+
+	// Migrate will create or upgrade the tables of our results repository without seeding dummy data.
+	Migrate() error
+
+	// Record will store the result of a race and move the race to the given status in a single transaction.
+	Record(result *racing.RaceResult, status racing.RaceStatus, audit Audit) (*racing.RaceResult, error)
+
+	// Get will return the result of a race.
+	Get(raceID int64) (*racing.RaceResult, error)
+
+	// List will return the results recorded for the given races keyed by race id.
+	List(raceIDs []int64) (map[int64]*racing.RaceResult, error)
+}
+
+type resultsRepo struct {
+	db    *sql.DB
+	clock Clock
+	init  sync.Once
+}
+
+// NewResultsRepo creates a new results repository, reading the current time from clock.
+func NewResultsRepo(db *sql.DB, clock Clock) ResultsRepo {
+	return &resultsRepo{db: db, clock: clock}
+}
+
+// Init prepares the results repository tables.
+func (r *resultsRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.Migrate()
+	})
+
+	return err
+}
+
+// canRecordResult reports whether a result can be recorded for a race in the given status.
+// Closed races move through interim to final, and interim results can be recorded again once a protest is decided.
+func canRecordResult(current racing.RaceStatus) bool {
+	return current == racing.RaceStatus_RACE_STATUS_CLOSED || current == racing.RaceStatus_RACE_STATUS_INTERIM
+}
+
+func (r *resultsRepo) Record(result *racing.RaceResult, status racing.RaceStatus, audit Audit) (*racing.RaceResult, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var (
+		stored          racing.RaceStatus
+		advertisedStart time.Time
+	)
+
+	err = tx.QueryRow(`SELECT status, advertised_start_time FROM races WHERE id = ?`, result.RaceId).Scan(&stored, &advertisedStart)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRaceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	current := effectiveStatus(stored, advertisedStart, r.clock())
+	if !canRecordResult(current) {
+		return nil, fmt.Errorf("%w: can't record a result for a race that is %v", ErrIllegalStatusTransition, current)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM race_placings WHERE race_id = ?`, result.RaceId); err != nil {
+		return nil, err
+	}
+
+	for _, placing := range result.Placings {
+		if _, err := tx.Exec(`INSERT INTO race_placings(race_id, runner_id, position, margin) VALUES (?,?,?,?)`, result.RaceId, placing.RunnerId, placing.Position, placing.Margin); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.Exec(`INSERT OR REPLACE INTO race_results(race_id, protest_status, recorded_time) VALUES (?,?,?)`, result.RaceId, result.ProtestStatus, r.clock().UTC()); err != nil {
+		return nil, err
+	}
+
+	// The stored status guards the update as it does in SetStatus, so a concurrent change can't be overwritten.
+	updated, err := tx.Exec(`UPDATE races SET status = ?, version = version + 1 WHERE id = ? AND status = ?`, status, result.RaceId, stored)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := updated.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, fmt.Errorf("%w: race %d was changed concurrently", ErrIllegalStatusTransition, result.RaceId)
+	}
+
+	if err := appendRaceChange(tx, result.RaceId, racing.RaceChangeKind_RACE_CHANGE_KIND_STATUS_CHANGED, r.clock()); err != nil {
+		return nil, err
+	}
+
+	if err := appendRaceRevision(tx, result.RaceId, racing.RaceChangeKind_RACE_CHANGE_KIND_STATUS_CHANGED, audit, r.clock()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.Get(result.RaceId)
+}
+
+func (r *resultsRepo) Get(raceID int64) (*racing.RaceResult, error) {
+	results, err := r.List([]int64{raceID})
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := results[raceID]
+	if !ok {
+		return nil, ErrResultNotFound
+	}
+
+	return result, nil
+}
+
+func (r *resultsRepo) List(raceIDs []int64) (map[int64]*racing.RaceResult, error) {
+	results := make(map[int64]*racing.RaceResult)
+	if len(raceIDs) == 0 {
+		return results, nil
+	}
+
+	in := "(" + strings.Repeat("?,", len(raceIDs)-1) + "?)"
+	args := make([]interface{}, 0, len(raceIDs))
+	for _, raceID := range raceIDs {
+		args = append(args, raceID)
+	}
+
+	rows, err := r.db.Query(getResultQueries()[resultsList]+" WHERE race_id IN "+in, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			result       racing.RaceResult
+			recordedTime time.Time
+		)
+
+		if err := rows.Scan(&result.RaceId, &result.ProtestStatus, &recordedTime); err != nil {
+			return nil, err
+		}
+
+		result.RecordedTime = timestamppb.New(recordedTime)
+		results[result.RaceId] = &result
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	placings, err := r.db.Query(getResultQueries()[placingsList]+" WHERE race_id IN "+in+" ORDER BY race_id, position, runner_id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer placings.Close()
+
+	for placings.Next() {
+		var (
+			raceID  int64
+			placing racing.Placing
+		)
+
+		if err := placings.Scan(&raceID, &placing.RunnerId, &placing.Position, &placing.Margin); err != nil {
+			return nil, err
+		}
+
+		if result, ok := results[raceID]; ok {
+			result.Placings = append(result.Placings, &placing)
+		}
+	}
+
+	if err := placings.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		markDeadHeats(result.Placings)
+	}
+
+	return results, nil
+}
+
+// markDeadHeats flags placings that share their position with another runner, placings must be ordered by position.
+func markDeadHeats(placings []*racing.Placing) {
+	for i := 1; i < len(placings); i++ {
+		if placings[i].Position == placings[i-1].Position {
+			placings[i].DeadHeat = true
+			placings[i-1].DeadHeat = true
+		}
+	}
+}
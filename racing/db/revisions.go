@@ -0,0 +1,274 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// ErrRevisionNotFound is returned when a race has no revision with the requested number.
+var ErrRevisionNotFound = errors.New("race revision not found")
+
+// Audit identifies who made a change to a race and why, it is recorded with the revision the change makes.
+type Audit struct {
+	Actor  string
+	Reason string
+}
+
+// systemAudit is recorded for changes the service makes on its own.
+var systemAudit = Audit{Actor: "system"}
+
+// RevisionsRepo provides repository access to the revision history of races.
+type RevisionsRepo interface {
+	// Init will initialise our revisions repository.
+	Init() error
+
+	// Migrate will create or upgrade the tables of our revisions repository without seeding dummy data.
+	Migrate() error
+
+	// List will return the revisions of a race with the fields each one changed, oldest first.
+	List(raceID int64) ([]*racing.RaceRevision, error)
+
+	// Get will return a race as it was stored at a revision, or ErrRevisionNotFound when there is no such revision.
+	Get(raceID int64, revision int64) (*racing.Race, error)
+}
+
+type revisionsRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// NewRevisionsRepo creates a new revisions repository.
+func NewRevisionsRepo(db *sql.DB) RevisionsRepo {
+	return &revisionsRepo{db: db}
+}
+
+// Init prepares the revisions repository table.
+func (r *revisionsRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.Migrate()
+	})
+
+	return err
+}
+
+// revision is a stored revision, holding the race as it was after the write.
+type revision struct {
+	racing.RaceRevision
+	race racing.Race
+}
+
+func (r *revisionsRepo) List(raceID int64) ([]*racing.RaceRevision, error) {
+	revisions, err := r.scanRevisions(getRevisionQueries()[revisionsList]+" WHERE race_id = ? ORDER BY revision", raceID)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded, err := r.recordedChanges(raceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		listed   []*racing.RaceRevision
+		previous *racing.Race
+	)
+
+	for _, rev := range revisions {
+		rev.Changes = append(raceFieldChanges(previous, &rev.race), recorded[rev.Revision]...)
+		previous = &rev.race
+
+		listed = append(listed, &rev.RaceRevision)
+	}
+
+	return listed, nil
+}
+
+func (r *revisionsRepo) Get(raceID int64, revision int64) (*racing.Race, error) {
+	revisions, err := r.scanRevisions(getRevisionQueries()[revisionsList]+" WHERE race_id = ? AND revision = ?", raceID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(revisions) == 0 {
+		return nil, ErrRevisionNotFound
+	}
+
+	return &revisions[0].race, nil
+}
+
+// recordedChanges returns the changes recorded for the revisions of a race keyed by revision, in the order they were made.
+func (r *revisionsRepo) recordedChanges(raceID int64) (map[int64][]*racing.RaceFieldChange, error) {
+	rows, err := r.db.Query(`SELECT revision, field, old_value, new_value FROM race_revision_changes WHERE race_id = ? ORDER BY rowid`, raceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	recorded := make(map[int64][]*racing.RaceFieldChange)
+	for rows.Next() {
+		var (
+			revision int64
+			change   racing.RaceFieldChange
+		)
+
+		if err := rows.Scan(&revision, &change.Field, &change.OldValue, &change.NewValue); err != nil {
+			return nil, err
+		}
+
+		recorded[revision] = append(recorded[revision], &change)
+	}
+
+	return recorded, rows.Err()
+}
+
+func (r *revisionsRepo) scanRevisions(query string, args ...interface{}) ([]*revision, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*revision
+
+	for rows.Next() {
+		var (
+			rev             revision
+			changeTime      time.Time
+			advertisedStart time.Time
+		)
+
+		if err := rows.Scan(
+			&rev.RaceId,
+			&rev.Revision,
+			&rev.Kind,
+			&rev.Actor,
+			&rev.Reason,
+			&changeTime,
+			&rev.race.MeetingId,
+			&rev.race.Name,
+			&rev.race.Number,
+			&rev.race.Visible,
+			&advertisedStart,
+			&rev.race.Status,
+		); err != nil {
+			return nil, err
+		}
+
+		rev.ChangeTime = timestamppb.New(changeTime)
+		rev.race.Id = rev.RaceId
+		rev.race.AdvertisedStartTime = timestamppb.New(advertisedStart)
+		rev.race.Etag = strconv.FormatInt(rev.Revision, 10)
+		// The status is reported as it read when the change was made.
+		rev.race.Status = effectiveStatus(rev.race.Status, advertisedStart, changeTime)
+
+		revisions = append(revisions, &rev)
+	}
+
+	return revisions, rows.Err()
+}
+
+// raceFieldChanges lists the recorded race fields that differ between two revisions of a race.
+// The first revision has no race before it, so every field is listed without an old value.
+func raceFieldChanges(before, after *racing.Race) []*racing.RaceFieldChange {
+	var (
+		changes []*racing.RaceFieldChange
+		old     []raceField
+	)
+
+	if before != nil {
+		old = raceFields(before)
+	}
+
+	for k, field := range raceFields(after) {
+		if old == nil {
+			changes = append(changes, &racing.RaceFieldChange{Field: field.name, NewValue: field.value})
+		} else if old[k].value != field.value {
+			changes = append(changes, &racing.RaceFieldChange{Field: field.name, OldValue: old[k].value, NewValue: field.value})
+		}
+	}
+
+	return changes
+}
+
+// raceField is a recorded race field, formatted as it is listed in a change.
+type raceField struct {
+	name, value string
+}
+
+// raceFields returns the recorded fields of a race, in the order changes are listed.
+func raceFields(race *racing.Race) []raceField {
+	return []raceField{
+		{"meeting_id", formatInt(race.MeetingId)},
+		{"name", race.Name},
+		{"number", formatInt(race.Number)},
+		{"visible", strconv.FormatBool(race.Visible)},
+		{"advertised_start_time", formatTimestamp(race.AdvertisedStartTime)},
+		{"status", formatStatus(race.Status)},
+	}
+}
+
+// formatInt formats an id or number, leaving zero empty as it means the field isn't set.
+func formatInt(v int64) string {
+	if v == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(v, 10)
+}
+
+// formatTimestamp formats a timestamp in RFC 3339, leaving an unset one empty.
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	return ts.AsTime().UTC().Format(time.RFC3339Nano)
+}
+
+// formatStatus formats a race status by name, leaving an unspecified one empty.
+func formatStatus(status racing.RaceStatus) string {
+	if status == racing.RaceStatus_RACE_STATUS_UNSPECIFIED {
+		return ""
+	}
+
+	return status.String()
+}
+
+// appendRaceRevision records the race as stored after a write made at the given time, it runs inside the transaction
+// making the write so the history never misses a committed write nor holds a rolled back one. The race's version numbers the revision.
+func appendRaceRevision(tx *sql.Tx, raceID int64, kind racing.RaceChangeKind, audit Audit, at time.Time) error {
+	_, err := tx.Exec(
+		`INSERT INTO race_revisions(race_id, revision, kind, actor, reason, change_time, meeting_id, name, number, visible, advertised_start_time, status)
+		SELECT id, version, ?, ?, ?, ?, meeting_id, name, number, visible, advertised_start_time, status FROM races WHERE id = ?`,
+		kind,
+		audit.Actor,
+		audit.Reason,
+		at.UTC(),
+		raceID,
+	)
+
+	return err
+}
+
+// appendRevisionChange records a change a write made outside the race's own fields, such as to one of its runners,
+// against the revision the write made. It runs inside the write's transaction once the race's version has moved on.
+func appendRevisionChange(tx *sql.Tx, raceID int64, change *racing.RaceFieldChange) error {
+	_, err := tx.Exec(
+		`INSERT INTO race_revision_changes(race_id, revision, field, old_value, new_value) SELECT id, version, ?, ?, ? FROM races WHERE id = ?`,
+		change.Field,
+		change.OldValue,
+		change.NewValue,
+		raceID,
+	)
+
+	return err
+}
@@ -0,0 +1,176 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// ErrRunnerNotFound is returned when no runner has the requested id.
+var ErrRunnerNotFound = errors.New("runner not found")
+
+// RunnersRepo provides repository access to race runners.
+type RunnersRepo interface {
+	// Init will initialise our runners repository.
+	Init() error
+
+	// Migrate will create or upgrade the tables of our runners repository without seeding dummy data.
+	Migrate() error
+
+	// List will return the runners of the given races ordered by race and saddle number.
+	List(raceIDs []int64, excludeScratched bool) ([]*racing.Runner, error)
+
+	// Get will return a runner by id.
+	Get(id int64) (*racing.Runner, error)
+
+	// Scratch will mark a runner as withdrawn now, scratching a scratched runner changes nothing.
+	// A scratching makes a new revision of the runner's race, recorded with the audit.
+	Scratch(id int64, audit Audit) (*racing.Runner, error)
+}
+
+type runnersRepo struct {
+	db    *sql.DB
+	clock Clock
+	init  sync.Once
+}
+
+// NewRunnersRepo creates a new runners repository, reading the current time from clock.
+func NewRunnersRepo(db *sql.DB, clock Clock) RunnersRepo {
+	return &runnersRepo{db: db, clock: clock}
+}
+
+// Init prepares the runners repository table, dummy runners are seeded along with the races by the Seeder.
+func (r *runnersRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		err = r.Migrate()
+	})
+
+	return err
+}
+
+func (r *runnersRepo) List(raceIDs []int64, excludeScratched bool) ([]*racing.Runner, error) {
+	if len(raceIDs) == 0 {
+		return nil, nil
+	}
+
+	query := getRunnerQueries()[runnersList] + " WHERE race_id IN (" + strings.Repeat("?,", len(raceIDs)-1) + "?)"
+	args := make([]interface{}, 0, len(raceIDs))
+	for _, raceID := range raceIDs {
+		args = append(args, raceID)
+	}
+
+	if excludeScratched {
+		query += " AND scratched = 0"
+	}
+
+	rows, err := r.db.Query(query+" ORDER BY race_id, saddle_number", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.scanRunners(rows)
+}
+
+func (r *runnersRepo) Get(id int64) (*racing.Runner, error) {
+	rows, err := r.db.Query(getRunnerQueries()[runnersList]+" WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	runners, err := r.scanRunners(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(runners) == 0 {
+		return nil, ErrRunnerNotFound
+	}
+
+	return runners[0], nil
+}
+
+func (r *runnersRepo) Scratch(id int64, audit Audit) (*racing.Runner, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var raceID int64
+	err = tx.QueryRow(`SELECT race_id FROM runners WHERE id = ?`, id).Scan(&raceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRunnerNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := tx.Exec(`UPDATE runners SET scratched = 1, scratch_time = ? WHERE id = ? AND scratched = 0`, r.clock().UTC(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	// A scratching changes the race card, so it is logged against the race and revises it.
+	if affected > 0 {
+		if _, err := tx.Exec(`UPDATE races SET version = version + 1 WHERE id = ?`, raceID); err != nil {
+			return nil, err
+		}
+
+		if err := appendRaceChange(tx, raceID, racing.RaceChangeKind_RACE_CHANGE_KIND_UPDATED, r.clock()); err != nil {
+			return nil, err
+		}
+
+		if err := appendRaceRevision(tx, raceID, racing.RaceChangeKind_RACE_CHANGE_KIND_UPDATED, audit, r.clock()); err != nil {
+			return nil, err
+		}
+
+		scratched := &racing.RaceFieldChange{Field: fmt.Sprintf("runners[%d].scratched", id), OldValue: "false", NewValue: "true"}
+		if err := appendRevisionChange(tx, raceID, scratched); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.Get(id)
+}
+
+func (r *runnersRepo) scanRunners(rows *sql.Rows) ([]*racing.Runner, error) {
+	defer rows.Close()
+
+	var runners []*racing.Runner
+
+	for rows.Next() {
+		var (
+			runner      racing.Runner
+			scratchTime sql.NullTime
+		)
+
+		if err := rows.Scan(&runner.Id, &runner.RaceId, &runner.SaddleNumber, &runner.Barrier, &runner.Name, &runner.Jockey, &runner.Trainer, &runner.Weight, &runner.Scratched, &scratchTime); err != nil {
+			return nil, err
+		}
+
+		if scratchTime.Valid {
+			runner.ScratchTime = timestamppb.New(scratchTime.Time)
+		}
+
+		runners = append(runners, &runner)
+	}
+
+	return runners, rows.Err()
+}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidQuery is returned when a search query holds no terms that can be searched for.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// seedSearch creates the races_fts index over race names and meeting venues, and the triggers keeping it in sync.
+// FTS5 is only compiled into the sqlite driver when building with -tags sqlite_fts5.
+func (r *racesRepo) seedSearch() error {
+	created, err := createSearchTable(r.db, "races_fts", "name, venue")
+	if err != nil {
+		return err
+	}
+
+	triggers := []string{
+		`CREATE TRIGGER IF NOT EXISTS races_fts_insert AFTER INSERT ON races BEGIN
+			INSERT INTO races_fts(rowid, name, venue) VALUES (new.id, new.name, COALESCE((SELECT venue FROM meetings WHERE id = new.meeting_id), ''));
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS races_fts_update AFTER UPDATE OF name, meeting_id ON races BEGIN
+			DELETE FROM races_fts WHERE rowid = old.id;
+			INSERT INTO races_fts(rowid, name, venue) VALUES (new.id, new.name, COALESCE((SELECT venue FROM meetings WHERE id = new.meeting_id), ''));
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS races_fts_delete AFTER DELETE ON races BEGIN
+			DELETE FROM races_fts WHERE rowid = old.id;
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS meetings_fts_insert AFTER INSERT ON meetings BEGIN
+			UPDATE races_fts SET venue = new.venue WHERE rowid IN (SELECT id FROM races WHERE meeting_id = new.id);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS meetings_fts_update AFTER UPDATE OF venue ON meetings BEGIN
+			UPDATE races_fts SET venue = new.venue WHERE rowid IN (SELECT id FROM races WHERE meeting_id = new.id);
+		END`,
+	}
+
+	for _, trigger := range triggers {
+		if _, err := r.db.Exec(trigger); err != nil {
+			return err
+		}
+	}
+
+	if !created {
+		return nil
+	}
+
+	// Races stored before the index existed are indexed once, the triggers keep it in sync from then on.
+	_, err = r.db.Exec(`INSERT INTO races_fts(rowid, name, venue) SELECT r.id, r.name, COALESCE(m.venue, '') FROM races r LEFT JOIN meetings m ON m.id = r.meeting_id`)
+
+	return err
+}
+
+// createSearchTable creates an FTS5 table over the given columns when it is missing, reporting whether it was created.
+func createSearchTable(db *sql.DB, table, columns string) (bool, error) {
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?`, table).Scan(&count); err != nil {
+		return false, err
+	}
+
+	if count > 0 {
+		return false, nil
+	}
+
+	if _, err := db.Exec(`CREATE VIRTUAL TABLE ` + table + ` USING fts5(` + columns + `)`); err != nil {
+		return false, fmt.Errorf("creating %s, the binary must be built with -tags sqlite_fts5: %w", table, err)
+	}
+
+	return true, nil
+}
+
+// matchQuery turns free text into an FTS5 query matching rows that hold every term as a prefix.
+// Terms are quoted so the FTS5 query syntax is searched for literally rather than interpreted.
+func matchQuery(text string) (string, error) {
+	var terms []string
+	for _, term := range strings.Fields(text) {
+		// Terms without letters or digits tokenize to nothing, so they can't match.
+		if strings.IndexFunc(term, func(c rune) bool { return unicode.IsLetter(c) || unicode.IsNumber(c) }) < 0 {
+			continue
+		}
+
+		terms = append(terms, `"`+strings.ReplaceAll(term, `"`, `""`)+`"*`)
+	}
+
+	if len(terms) == 0 {
+		return "", fmt.Errorf("%w: %q holds no letters or digits", ErrInvalidQuery, text)
+	}
+
+	return strings.Join(terms, " "), nil
+}
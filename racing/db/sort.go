@@ -0,0 +1,104 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// ErrInvalidSort is returned when a sort spec can't be applied to races.
+var ErrInvalidSort = errors.New("invalid sort")
+
+// raceSortColumns allow-lists the columns races can be ordered by, nothing else reaches ORDER BY.
+var raceSortColumns = map[racing.RaceSortField]string{
+	racing.RaceSortField_RACE_SORT_FIELD_ID:                    "id",
+	racing.RaceSortField_RACE_SORT_FIELD_MEETING_ID:            "meeting_id",
+	racing.RaceSortField_RACE_SORT_FIELD_NAME:                  "name",
+	racing.RaceSortField_RACE_SORT_FIELD_NUMBER:                "number",
+	racing.RaceSortField_RACE_SORT_FIELD_VISIBLE:               "visible",
+	racing.RaceSortField_RACE_SORT_FIELD_ADVERTISED_START_TIME: "advertised_start_time",
+}
+
+// sortKey is a validated column of an ORDER BY clause.
+type sortKey struct {
+	column string
+	desc   bool
+}
+
+// raceSortKeys validates a sort spec and converts it into sort keys.
+// id is appended as the last key unless already present, so every row has a single position in the ordering.
+func raceSortKeys(sort []*racing.RaceSort) ([]sortKey, error) {
+	var keys []sortKey
+	seen := make(map[string]bool)
+
+	for i, s := range sort {
+		column, ok := raceSortColumns[s.GetField()]
+		if !ok {
+			return nil, fmt.Errorf("%w: sort[%d].field %v is not a sortable race field", ErrInvalidSort, i, s.GetField())
+		}
+
+		if seen[column] {
+			return nil, fmt.Errorf("%w: sort[%d].field %v is repeated", ErrInvalidSort, i, s.GetField())
+		}
+		seen[column] = true
+
+		key := sortKey{column: column}
+		switch s.GetDirection() {
+		case racing.SortDirection_SORT_DIRECTION_UNSPECIFIED, racing.SortDirection_SORT_DIRECTION_ASC:
+		case racing.SortDirection_SORT_DIRECTION_DESC:
+			key.desc = true
+		default:
+			return nil, fmt.Errorf("%w: sort[%d].direction %v is not a sort direction", ErrInvalidSort, i, s.GetDirection())
+		}
+
+		keys = append(keys, key)
+	}
+
+	if !seen["id"] {
+		keys = append(keys, sortKey{column: "id"})
+	}
+
+	return keys, nil
+}
+
+// orderBy renders sort keys as an ORDER BY clause.
+func orderBy(keys []sortKey) string {
+	columns := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key.desc {
+			columns = append(columns, key.column+" DESC")
+		} else {
+			columns = append(columns, key.column+" ASC")
+		}
+	}
+
+	return " ORDER BY " + strings.Join(columns, ", ")
+}
+
+// seekAfter renders the keyset condition selecting rows ordered after the row holding values, one per key,
+// along with its arguments.
+func seekAfter(keys []sortKey, values []sortValue) (string, []interface{}) {
+	var (
+		alternatives []string
+		equal        []string
+		equalArgs    []interface{}
+		args         []interface{}
+	)
+
+	for i, key := range keys {
+		operator := " > ?"
+		if key.desc {
+			operator = " < ?"
+		}
+
+		alternatives = append(alternatives, "("+strings.Join(append(equal, key.column+operator), " AND ")+")")
+		args = append(append(args, equalArgs...), values[i].arg())
+
+		equal = append(equal, key.column+" = ?")
+		equalArgs = append(equalArgs, values[i].arg())
+	}
+
+	return "(" + strings.Join(alternatives, " OR ") + ")", args
+}
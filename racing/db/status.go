@@ -0,0 +1,147 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+var (
+	// ErrRaceNotFound is returned when no race has the requested id.
+	ErrRaceNotFound = errors.New("race not found")
+
+	// ErrIllegalStatusTransition is returned when a race can't move from its current status to the requested one.
+	ErrIllegalStatusTransition = errors.New("illegal race status transition")
+)
+
+// raceStatusTransitions lists the statuses each status can move to.
+// Final and abandoned races are settled, so they can't move anywhere.
+var raceStatusTransitions = map[racing.RaceStatus][]racing.RaceStatus{
+	racing.RaceStatus_RACE_STATUS_OPEN: {
+		racing.RaceStatus_RACE_STATUS_SUSPENDED,
+		racing.RaceStatus_RACE_STATUS_CLOSED,
+		racing.RaceStatus_RACE_STATUS_ABANDONED,
+	},
+	racing.RaceStatus_RACE_STATUS_SUSPENDED: {
+		racing.RaceStatus_RACE_STATUS_OPEN,
+		racing.RaceStatus_RACE_STATUS_CLOSED,
+		racing.RaceStatus_RACE_STATUS_ABANDONED,
+	},
+	racing.RaceStatus_RACE_STATUS_CLOSED: {
+		racing.RaceStatus_RACE_STATUS_SUSPENDED,
+		racing.RaceStatus_RACE_STATUS_INTERIM,
+		racing.RaceStatus_RACE_STATUS_ABANDONED,
+	},
+	racing.RaceStatus_RACE_STATUS_INTERIM: {
+		racing.RaceStatus_RACE_STATUS_CLOSED,
+		racing.RaceStatus_RACE_STATUS_FINAL,
+		racing.RaceStatus_RACE_STATUS_ABANDONED,
+	},
+}
+
+// canTransition reports whether a race may move from one status to another.
+func canTransition(from, to racing.RaceStatus) bool {
+	for _, status := range raceStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// effectiveStatus is the status reported for a race, stored open races close once their advertised start time passes.
+func effectiveStatus(stored racing.RaceStatus, advertisedStart time.Time, now time.Time) racing.RaceStatus {
+	if stored == racing.RaceStatus_RACE_STATUS_OPEN && now.After(advertisedStart) {
+		return racing.RaceStatus_RACE_STATUS_CLOSED
+	}
+
+	return stored
+}
+
+// Races are replayed at an instant from their revision history. Before its first revision a race is reported open,
+// as every race starts out, and with the advertised start time it was first recorded with.
+var (
+	// statusAtExpr is the stored status of a race at the instant bound to it.
+	statusAtExpr = fmt.Sprintf(
+		`COALESCE((SELECT h.status FROM race_revisions h WHERE h.race_id = races.id AND h.change_time <= ? ORDER BY h.revision DESC LIMIT 1), %d)`,
+		racing.RaceStatus_RACE_STATUS_OPEN,
+	)
+
+	// advertisedStartAtExpr is the advertised start time of a race at the instant bound to it.
+	advertisedStartAtExpr = `COALESCE(
+		(SELECT h.advertised_start_time FROM race_revisions h WHERE h.race_id = races.id AND h.change_time <= ? ORDER BY h.revision DESC LIMIT 1),
+		(SELECT h.advertised_start_time FROM race_revisions h WHERE h.race_id = races.id ORDER BY h.revision LIMIT 1),
+		races.advertised_start_time)`
+)
+
+// replayAt reports the status and advertised start time of races as their revision history had them at asOf.
+// Fields left out by a read mask stay unset.
+func (r *racesRepo) replayAt(races []*racing.Race, asOf time.Time) error {
+	if len(races) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*racing.Race, len(races))
+	args := []interface{}{asOf.UTC(), asOf.UTC()}
+	for _, race := range races {
+		byID[race.Id] = race
+		args = append(args, race.Id)
+	}
+
+	rows, err := r.db.Query(
+		`SELECT id, `+statusAtExpr+`, `+advertisedStartAtExpr+` FROM races WHERE id IN (`+strings.Repeat("?,", len(races)-1)+`?)`,
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id              int64
+			stored          racing.RaceStatus
+			advertisedStart string
+		)
+
+		if err := rows.Scan(&id, &stored, &advertisedStart); err != nil {
+			return err
+		}
+
+		// The value of an expression has no declared type, so the driver leaves times as text.
+		start, err := parseStoredTime(advertisedStart)
+		if err != nil {
+			return err
+		}
+
+		race := byID[id]
+		if race.AdvertisedStartTime != nil {
+			race.AdvertisedStartTime = timestamppb.New(start)
+		}
+
+		if race.Status != racing.RaceStatus_RACE_STATUS_UNSPECIFIED {
+			race.Status = effectiveStatus(stored, start, asOf)
+		}
+	}
+
+	return rows.Err()
+}
+
+// parseStoredTime parses a time stored as text, in any of the formats the sqlite driver reads time columns in.
+func parseStoredTime(s string) (time.Time, error) {
+	s = strings.TrimSuffix(s, "Z")
+	for _, format := range sqlite3.SQLiteTimestampFormats {
+		if t, err := time.ParseInLocation(format, s, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognised stored time %q", s)
+}
@@ -0,0 +1,138 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	// Embeds the time zone database, so venue time zones load on hosts without one.
+	_ "time/tzdata"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// VenuesRepo provides repository access to the racecourses meetings are held at.
+// Meetings are matched to venues when it is initialised, so the meetings repository must be initialised first.
+type VenuesRepo interface {
+	// Init will initialise our venues repository.
+	Init() error
+
+	// Migrate will create or upgrade the tables of our venues repository without seeding dummy data.
+	Migrate() error
+
+	// List will return a list of venues.
+	List(filter *racing.ListVenuesRequestFilter) ([]*racing.Venue, error)
+
+	// Locations will return the time zone of the venue of each of the given meetings, keyed by meeting id.
+	// Meetings at unknown venues are left out.
+	Locations(meetingIDs []int64) (map[int64]*time.Location, error)
+}
+
+type venuesRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// NewVenuesRepo creates a new venues repository.
+func NewVenuesRepo(db *sql.DB) VenuesRepo {
+	return &venuesRepo{db: db}
+}
+
+// Init prepares the venues repository dummy data.
+func (v *venuesRepo) Init() error {
+	var err error
+
+	v.init.Do(func() {
+		err = v.Migrate()
+	})
+
+	return err
+}
+
+func (v *venuesRepo) List(filter *racing.ListVenuesRequestFilter) ([]*racing.Venue, error) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	if ids := filter.GetIds(); len(ids) > 0 {
+		clauses = append(clauses, "id IN ("+strings.Repeat("?,", len(ids)-1)+"?)")
+
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+
+	if country := filter.GetCountry(); country != "" {
+		clauses = append(clauses, "country = ?")
+		args = append(args, strings.ToUpper(country))
+	}
+
+	query := `SELECT id, name, city, country, latitude, longitude, timezone FROM venues`
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	rows, err := v.db.Query(query+" ORDER BY name, id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var venues []*racing.Venue
+
+	for rows.Next() {
+		var venue racing.Venue
+
+		if err := rows.Scan(&venue.Id, &venue.Name, &venue.City, &venue.Country, &venue.Latitude, &venue.Longitude, &venue.Timezone); err != nil {
+			return nil, err
+		}
+
+		venues = append(venues, &venue)
+	}
+
+	return venues, rows.Err()
+}
+
+func (v *venuesRepo) Locations(meetingIDs []int64) (map[int64]*time.Location, error) {
+	if len(meetingIDs) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(meetingIDs))
+	for _, id := range meetingIDs {
+		args = append(args, id)
+	}
+
+	rows, err := v.db.Query(
+		`SELECT m.id, v.timezone FROM meetings m JOIN venues v ON v.id = m.venue_id
+		WHERE m.id IN (`+strings.Repeat("?,", len(meetingIDs)-1)+`?)`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	locations := make(map[int64]*time.Location)
+	for rows.Next() {
+		var (
+			meetingID int64
+			timezone  string
+		)
+
+		if err := rows.Scan(&meetingID, &timezone); err != nil {
+			return nil, err
+		}
+
+		location, err := time.LoadLocation(timezone)
+		if err != nil {
+			return nil, err
+		}
+
+		locations[meetingID] = location
+	}
+
+	return locations, rows.Err()
+}
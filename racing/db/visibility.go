@@ -0,0 +1,29 @@
+package db
+
+import "git.neds.sh/matty/entain/racing/proto/racing"
+
+// FilterVisibility resolves the visibility a filter selects. The deprecated visible flag selects visible races when
+// visibility is left as VISIBILITY_ANY, so older clients keep getting what they asked for.
+func FilterVisibility(filter *racing.ListRacesRequestFilter) racing.Visibility {
+	if visibility := filter.GetVisibility(); visibility != racing.Visibility_VISIBILITY_ANY {
+		return visibility
+	}
+
+	if filter.GetVisible() {
+		return racing.Visibility_VISIBILITY_VISIBLE
+	}
+
+	return racing.Visibility_VISIBILITY_ANY
+}
+
+// visibilityClause returns the clause selecting races with the given visibility, it is empty for VISIBILITY_ANY.
+func visibilityClause(visibility racing.Visibility) string {
+	switch visibility {
+	case racing.Visibility_VISIBILITY_VISIBLE:
+		return "visible = 1"
+	case racing.Visibility_VISIBILITY_HIDDEN:
+		return "visible = 0"
+	}
+
+	return ""
+}
@@ -0,0 +1,448 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"git.neds.sh/matty/entain/racing/db"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// errRowsRejected is returned when an import holds invalid rows, they are reported and nothing is stored.
+var errRowsRejected = errors.New("rows were rejected, nothing was imported")
+
+// importableRaceFields are the race fields an import file may set, the rest are assigned by the service.
+var importableRaceFields = map[protoreflect.Name]bool{
+	"id":                    true,
+	"meeting_id":            true,
+	"name":                  true,
+	"number":                true,
+	"visible":               true,
+	"advertised_start_time": true,
+}
+
+// countryCode matches an ISO 3166-1 alpha-3 country code.
+var countryCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// rowError is a problem with one row of an import file, field is empty when it isn't down to a single field.
+type rowError struct {
+	file  string
+	line  int
+	field string
+	err   string
+}
+
+func (e rowError) String() string {
+	if e.field == "" {
+		return fmt.Sprintf("%s:%d: %s", e.file, e.line, e.err)
+	}
+
+	return fmt.Sprintf("%s:%d: %s: %s", e.file, e.line, e.field, e.err)
+}
+
+// row is a parsed row of an import file, along with the line it was read from.
+type row struct {
+	line int
+	msg  proto.Message
+}
+
+// runImport loads meetings and races from CSV or NDJSON files into the racing database.
+// Every row is validated first, and the rows are only stored when none of them is rejected.
+func runImport(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	dbPath := flags.String("db", "./db/racing.db", "racing database to import into")
+	meetingsPath := flags.String("meetings", "", "CSV or NDJSON file of meetings to import")
+	racesPath := flags.String("races", "", "CSV or NDJSON file of races to import")
+	actor := flags.String("actor", "import", "actor recorded in the revision history of imported races")
+	reason := flags.String("reason", "", "reason recorded in the revision history of imported races, defaults to the races file name")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *meetingsPath == "" && *racesPath == "" {
+		return errors.New("nothing to import, pass -meetings, -races or both")
+	}
+
+	racingDB, err := sql.Open("sqlite3", *dbPath)
+	if err != nil {
+		return err
+	}
+	defer racingDB.Close()
+
+	// The repositories create the tables and triggers the import writes to, as the server does,
+	// but without the server's dummy data so a fresh database holds only what was imported.
+	meetingsRepo := db.NewMeetingsRepo(racingDB)
+	migrations := []interface{ Migrate() error }{
+		meetingsRepo,
+		db.NewVenuesRepo(racingDB),
+		db.NewRacesRepo(racingDB, time.Now),
+		db.NewRunnersRepo(racingDB, time.Now),
+		db.NewResultsRepo(racingDB, time.Now),
+		db.NewPricesRepo(racingDB, time.Now),
+		db.NewChangesRepo(racingDB),
+		db.NewRevisionsRepo(racingDB),
+	}
+
+	for _, repo := range migrations {
+		if err := repo.Migrate(); err != nil {
+			return err
+		}
+	}
+
+	var (
+		rejected []rowError
+		meetings []*racing.Meeting
+		races    []*racing.Race
+	)
+
+	// Races may point at meetings stored earlier or at meetings of this import.
+	knownMeetings := make(map[int64]bool)
+
+	if *meetingsPath != "" {
+		rows, fileErrs, err := readRows(*meetingsPath, func() proto.Message { return &racing.Meeting{} })
+		if err != nil {
+			return err
+		}
+
+		seen := make(map[int64]int)
+		for _, r := range rows {
+			meeting := r.msg.(*racing.Meeting)
+
+			errs := validateMeeting(meeting)
+			if first, ok := seen[meeting.Id]; ok && meeting.Id != 0 {
+				errs = append(errs, rowError{field: "id", err: fmt.Sprintf("meeting %d is repeated from line %d", meeting.Id, first)})
+			}
+			seen[meeting.Id] = r.line
+
+			if len(errs) > 0 {
+				fileErrs = append(fileErrs, located(errs, *meetingsPath, r.line)...)
+				continue
+			}
+
+			meetings = append(meetings, meeting)
+			knownMeetings[meeting.Id] = true
+		}
+
+		rejected = append(rejected, byLine(fileErrs)...)
+	}
+
+	if *racesPath != "" {
+		rows, fileErrs, err := readRows(*racesPath, func() proto.Message { return &racing.Race{} })
+		if err != nil {
+			return err
+		}
+
+		seen := make(map[int64]int)
+		for _, r := range rows {
+			race := r.msg.(*racing.Race)
+
+			errs := validateRace(race)
+			if first, ok := seen[race.Id]; ok && race.Id != 0 {
+				errs = append(errs, rowError{field: "id", err: fmt.Sprintf("race %d is repeated from line %d", race.Id, first)})
+			}
+			seen[race.Id] = r.line
+
+			if race.MeetingId > 0 && !knownMeetings[race.MeetingId] {
+				_, err := meetingsRepo.Get(race.MeetingId)
+				switch {
+				case errors.Is(err, db.ErrMeetingNotFound):
+					errs = append(errs, rowError{field: "meeting_id", err: fmt.Sprintf("meeting %d doesn't exist", race.MeetingId)})
+				case err != nil:
+					return err
+				default:
+					knownMeetings[race.MeetingId] = true
+				}
+			}
+
+			if len(errs) > 0 {
+				fileErrs = append(fileErrs, located(errs, *racesPath, r.line)...)
+				continue
+			}
+
+			races = append(races, race)
+		}
+
+		rejected = append(rejected, byLine(fileErrs)...)
+	}
+
+	if len(rejected) > 0 {
+		for _, e := range rejected {
+			fmt.Fprintln(out, e)
+		}
+
+		return fmt.Errorf("%w: %d problems found", errRowsRejected, len(rejected))
+	}
+
+	audit := db.Audit{Actor: *actor, Reason: *reason}
+	if audit.Reason == "" && *racesPath != "" {
+		audit.Reason = "imported from " + filepath.Base(*racesPath)
+	}
+
+	summary, err := db.NewImportRepo(racingDB, time.Now).Import(meetings, races, audit)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "meetings: %d created, %d updated, %d unchanged\n", summary.MeetingsCreated, summary.MeetingsUpdated, len(meetings)-summary.MeetingsCreated-summary.MeetingsUpdated)
+	fmt.Fprintf(out, "races: %d created, %d updated, %d unchanged\n", summary.RacesCreated, summary.RacesUpdated, len(races)-summary.RacesCreated-summary.RacesUpdated)
+
+	return nil
+}
+
+// located sets the file and line of row errors.
+func located(errs []rowError, file string, line int) []rowError {
+	for i := range errs {
+		errs[i].file = file
+		errs[i].line = line
+	}
+
+	return errs
+}
+
+// byLine orders the row errors of a file by line, keeping the order of errors on the same line.
+func byLine(errs []rowError) []rowError {
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].line < errs[j].line })
+
+	return errs
+}
+
+// readRows parses an import file into messages made by newMessage, its extension tells CSV from NDJSON.
+// Rows that can't be parsed are returned as row errors, while an error means the file itself can't be read.
+func readRows(path string, newMessage func() proto.Message) ([]row, []rowError, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readCSV(f, path, newMessage)
+	case ".ndjson", ".jsonl":
+		return readNDJSON(f, path, newMessage)
+	default:
+		return nil, nil, fmt.Errorf("%s: unknown format, use a .csv, .ndjson or .jsonl file", path)
+	}
+}
+
+// readNDJSON parses a file holding a JSON object per line, in the JSON mapping the API uses. Blank lines are skipped.
+func readNDJSON(r io.Reader, path string, newMessage func() proto.Message) ([]row, []rowError, error) {
+	var (
+		rows []row
+		errs []rowError
+	)
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		msg := newMessage()
+		if err := protojson.Unmarshal(text, msg); err != nil {
+			errs = append(errs, rowError{file: path, line: line, err: err.Error()})
+			continue
+		}
+
+		rows = append(rows, row{line: line, msg: msg})
+	}
+
+	return rows, errs, scanner.Err()
+}
+
+// readCSV parses a file whose header names the message field of each column, by its proto or JSON name.
+// Empty cells leave their field unset, and values are read as in the JSON mapping the API uses.
+func readCSV(r io.Reader, path string, newMessage func() proto.Message) ([]row, []rowError, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: reading header: %w", path, err)
+	}
+
+	fields := newMessage().ProtoReflect().Descriptor().Fields()
+	columns := make([]protoreflect.FieldDescriptor, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = fields.ByJSONName(name)
+		}
+
+		if fd == nil {
+			return nil, nil, fmt.Errorf("%s: column %q isn't a field", path, name)
+		}
+
+		columns[i] = fd
+	}
+
+	var (
+		rows []row
+		errs []rowError
+	)
+
+	// Rows are numbered from the header as line 1, which matches the file's lines unless a quoted cell spans several.
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			errs = append(errs, rowError{file: path, line: line, err: parseErr.Err.Error()})
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		msg := newMessage()
+		var rowErrs []rowError
+
+		for i, value := range record {
+			if value == "" {
+				continue
+			}
+
+			// Each cell is parsed on its own so a bad value is reported against its column.
+			if err := mergeCell(msg, columns[i], value); err != nil {
+				rowErrs = append(rowErrs, rowError{file: path, line: line, field: string(columns[i].Name()), err: err.Error()})
+			}
+		}
+
+		if len(rowErrs) > 0 {
+			errs = append(errs, rowErrs...)
+			continue
+		}
+
+		rows = append(rows, row{line: line, msg: msg})
+	}
+
+	return rows, errs, nil
+}
+
+// mergeCell parses a CSV cell as the JSON value of a field and merges it into msg.
+func mergeCell(msg proto.Message, fd protoreflect.FieldDescriptor, value string) error {
+	var cell interface{} = value
+
+	// The JSON mapping only takes bools unquoted, every other scalar can be quoted.
+	if fd.Kind() == protoreflect.BoolKind {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a bool", value)
+		}
+
+		cell = b
+	}
+
+	raw, err := json.Marshal(map[string]interface{}{fd.JSONName(): cell})
+	if err != nil {
+		return err
+	}
+
+	parsed := msg.ProtoReflect().New().Interface()
+	if err := protojson.Unmarshal(raw, parsed); err != nil {
+		switch {
+		case fd.Message() != nil:
+			return fmt.Errorf("%q is not a valid %s", value, fd.Message().Name())
+		case fd.Enum() != nil:
+			return fmt.Errorf("%q is not a valid %s", value, fd.Enum().Name())
+		}
+
+		return fmt.Errorf("%q is not a valid %s", value, fd.Kind())
+	}
+
+	proto.Merge(msg, parsed)
+
+	return nil
+}
+
+// validateMeeting checks an imported meeting, returning a row error for each invalid field.
+func validateMeeting(meeting *racing.Meeting) []rowError {
+	var errs []rowError
+
+	if meeting.Id <= 0 {
+		errs = append(errs, rowError{field: "id", err: "id must be positive"})
+	}
+
+	if strings.TrimSpace(meeting.Venue) == "" {
+		errs = append(errs, rowError{field: "venue", err: "venue must be set"})
+	}
+
+	if !countryCode.MatchString(meeting.Country) {
+		errs = append(errs, rowError{field: "country", err: fmt.Sprintf("%q is not an ISO 3166-1 alpha-3 country code", meeting.Country)})
+	}
+
+	if _, ok := racing.RaceType_name[int32(meeting.RaceType)]; !ok || meeting.RaceType == racing.RaceType_RACE_TYPE_UNSPECIFIED {
+		errs = append(errs, rowError{field: "race_type", err: fmt.Sprintf("%v is not a race type", meeting.RaceType)})
+	}
+
+	if _, err := time.Parse("2006-01-02", meeting.Date); err != nil {
+		errs = append(errs, rowError{field: "date", err: fmt.Sprintf("%q is not a YYYY-MM-DD date", meeting.Date)})
+	}
+
+	if _, ok := racing.TrackCondition_name[int32(meeting.TrackCondition)]; !ok {
+		errs = append(errs, rowError{field: "track_condition", err: fmt.Sprintf("%v is not a track condition", meeting.TrackCondition)})
+	}
+
+	return errs
+}
+
+// validateRace checks the fields of an imported race, returning a row error for each invalid field.
+// Whether its meeting exists is left to the caller.
+func validateRace(race *racing.Race) []rowError {
+	var errs []rowError
+
+	race.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !importableRaceFields[fd.Name()] {
+			errs = append(errs, rowError{field: string(fd.Name()), err: fmt.Sprintf("%s can't be imported", fd.Name())})
+		}
+
+		return true
+	})
+
+	if race.Id <= 0 {
+		errs = append(errs, rowError{field: "id", err: "id must be positive"})
+	}
+
+	if race.MeetingId <= 0 {
+		errs = append(errs, rowError{field: "meeting_id", err: "meeting_id must be positive"})
+	}
+
+	if strings.TrimSpace(race.Name) == "" {
+		errs = append(errs, rowError{field: "name", err: "name must be set"})
+	}
+
+	if race.Number <= 0 {
+		errs = append(errs, rowError{field: "number", err: "number must be positive"})
+	}
+
+	if race.AdvertisedStartTime == nil || race.AdvertisedStartTime.CheckValid() != nil {
+		errs = append(errs, rowError{field: "advertised_start_time", err: "advertised_start_time must be a valid timestamp"})
+	}
+
+	return errs
+}
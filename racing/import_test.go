@@ -0,0 +1,151 @@
+package main
+
+import (
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+func newRace() proto.Message {
+	return &racing.Race{}
+}
+
+func TestReadCSV(t *testing.T) {
+	file := "id,meetingId,name,number,visible,advertised_start_time\n" +
+		"201,11,Caulfield Cup,8,true,2026-10-18T05:15:00Z\n" +
+		"202,11,Bad Race,x,maybe,2026-10-18T06:00:00Z\n"
+
+	rows, errs, err := readCSV(strings.NewReader(file), "races.csv", newRace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 1 || rows[0].line != 2 {
+		t.Fatalf("Unexpected rows: %+v", rows)
+	}
+
+	race := rows[0].msg.(*racing.Race)
+	if race.Id != 201 || race.MeetingId != 11 || race.Name != "Caulfield Cup" || !race.Visible || race.AdvertisedStartTime.AsTime().Hour() != 5 {
+		t.Errorf("Unexpected race: %+v", race)
+	}
+
+	if len(errs) != 2 || errs[0].line != 3 || errs[0].field != "number" || errs[1].field != "visible" {
+		t.Errorf("Unexpected row errors: %+v", errs)
+	}
+
+	t.Run("Rejects an unknown column", func(t *testing.T) {
+		if _, _, err := readCSV(strings.NewReader("id,colour\n1,red\n"), "races.csv", newRace); err == nil {
+			t.Error("Expected an error for an unknown column")
+		}
+	})
+}
+
+func TestReadNDJSON(t *testing.T) {
+	file := `{"id": 201, "meeting_id": 11, "name": "Caulfield Cup", "number": 8, "advertisedStartTime": "2026-10-18T05:15:00Z"}
+
+{"id": "not a number"}
+`
+
+	rows, errs, err := readNDJSON(strings.NewReader(file), "races.ndjson", newRace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 1 || rows[0].msg.(*racing.Race).Name != "Caulfield Cup" {
+		t.Errorf("Unexpected rows: %+v", rows)
+	}
+
+	if len(errs) != 1 || errs[0].line != 3 {
+		t.Errorf("Unexpected row errors: %+v", errs)
+	}
+}
+
+func TestValidateRace(t *testing.T) {
+	tests := map[string]struct {
+		race   *racing.Race
+		fields []string
+	}{
+		"Accepts a complete race": {
+			race:   &racing.Race{Id: 1, MeetingId: 1, Name: "Cup", Number: 1, AdvertisedStartTime: timestamppb.Now()},
+			fields: nil,
+		},
+		"Rejects missing fields": {
+			race:   &racing.Race{},
+			fields: []string{"id", "meeting_id", "name", "number", "advertised_start_time"},
+		},
+		"Rejects fields the service assigns": {
+			race:   &racing.Race{Id: 1, MeetingId: 1, Name: "Cup", Number: 1, AdvertisedStartTime: timestamppb.Now(), Status: racing.RaceStatus_RACE_STATUS_CLOSED},
+			fields: []string{"status"},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			errs := validateRace(tt.race)
+			if len(errs) != len(tt.fields) {
+				t.Fatalf("Unexpected row errors: %+v (expected violations of %v)", errs, tt.fields)
+			}
+
+			for i, field := range tt.fields {
+				if errs[i].field != field {
+					t.Errorf("Unexpected row error: %+v (expected a violation of %s)", errs[i], field)
+				}
+			}
+		})
+	}
+}
+
+func TestImportIntoFreshDatabase(t *testing.T) {
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "racing.db")
+	meetingsPath := filepath.Join(dir, "meetings.csv")
+	racesPath := filepath.Join(dir, "races.csv")
+
+	meetings := "id,venue,country,race_type,date\n1,Caulfield,AUS,RACE_TYPE_THOROUGHBRED,2026-10-18\n"
+	races := "id,meeting_id,name,number,visible,advertised_start_time\n1,1,Caulfield Cup,8,true,2026-10-18T05:15:00Z\n"
+	if err := os.WriteFile(meetingsPath, []byte(meetings), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(racesPath, []byte(races), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runImport([]string{"-db", dbPath, "-meetings", meetingsPath, "-races", racesPath}, io.Discard); err != nil {
+		if strings.Contains(err.Error(), "sqlite_fts5") {
+			t.Skip("search needs the sqlite driver built with -tags sqlite_fts5")
+		}
+
+		t.Fatal(err)
+	}
+
+	racingDB, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer racingDB.Close()
+
+	// The server migrates the database as it starts, which must leave the imported fixtures as they are.
+	if _, err := initRepos(racingDB); err != nil {
+		t.Fatal(err)
+	}
+
+	for table, expected := range map[string]int{"meetings": 1, "races": 1, "runners": 0, "runner_prices": 0} {
+		var count int
+		if err := racingDB.QueryRow(`SELECT COUNT(*) FROM ` + table).Scan(&count); err != nil {
+			t.Fatal(err)
+		}
+
+		if count != expected {
+			t.Errorf("Unexpected number of %s: %d (expected %d)", table, count, expected)
+		}
+	}
+}
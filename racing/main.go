@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"flag"
 	"log"
 	"net"
+	"os"
+	"time"
 
 	"git.neds.sh/matty/entain/racing/db"
 	"git.neds.sh/matty/entain/racing/proto/racing"
@@ -15,9 +18,20 @@ import (
 var (
 	racingHost   = "localhost:9001"
 	grpcEndpoint = flag.String("grpc-endpoint", racingHost, "gRPC server endpoint")
+	maxBatchSize = flag.Int("max-batch-size", 100, "maximum number of ids a BatchGetRaces call can ask for")
+	closeEvery   = flag.Duration("close-interval", 2*time.Second, "how often open races past their advertised start time are stored as closed")
 )
 
 func main() {
+	// The import subcommand loads fixture files instead of serving, it takes its own flags.
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err := runImport(os.Args[2:], os.Stdout); err != nil {
+			log.Fatalf("failed importing: %s\n", err)
+		}
+
+		return
+	}
+
 	flag.Parse()
 
 	if err := run(); err != nil {
@@ -36,17 +50,34 @@ func run() error {
 		return err
 	}
 
-	racesRepo := db.NewRacesRepo(racingDB)
-	if err := racesRepo.Init(); err != nil {
+	repos, err := initRepos(racingDB)
+	if err != nil {
 		return err
 	}
 
-	grpcServer := grpc.NewServer()
+	// Started races are closed by a single job, read-only calls never write.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go service.CloseStartedRaces(ctx, repos.races, *closeEvery)
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(service.UnaryErrorInterceptor),
+		grpc.StreamInterceptor(service.StreamErrorInterceptor),
+	)
 
 	racing.RegisterRacingServer(
 		grpcServer,
 		service.NewRacingService(
-			racesRepo,
+			repos.races,
+			repos.meetings,
+			repos.runners,
+			repos.results,
+			repos.prices,
+			repos.changes,
+			repos.revisions,
+			repos.venues,
+			*maxBatchSize,
 		),
 	)
 
@@ -58,3 +89,49 @@ func run() error {
 
 	return nil
 }
+
+// repositories are the repositories the racing service is served from.
+type repositories struct {
+	races     db.RacesRepo
+	meetings  db.MeetingsRepo
+	runners   db.RunnersRepo
+	results   db.ResultsRepo
+	prices    db.PricesRepo
+	changes   db.ChangesRepo
+	revisions db.RevisionsRepo
+	venues    db.VenuesRepo
+}
+
+// initRepos initialises the repositories over a racing database, then seeds it with the dummy data it needs.
+func initRepos(racingDB *sql.DB) (*repositories, error) {
+	r := &repositories{
+		races:     db.NewRacesRepo(racingDB, time.Now),
+		meetings:  db.NewMeetingsRepo(racingDB),
+		runners:   db.NewRunnersRepo(racingDB, time.Now),
+		results:   db.NewResultsRepo(racingDB, time.Now),
+		prices:    db.NewPricesRepo(racingDB, time.Now),
+		changes:   db.NewChangesRepo(racingDB),
+		revisions: db.NewRevisionsRepo(racingDB),
+		venues:    db.NewVenuesRepo(racingDB),
+	}
+
+	// Whether the database needs dummy data is decided from its tables as they were stored.
+	seeder, err := db.NewSeeder(racingDB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Meetings are initialised first, as races are indexed for search along with their meeting venue.
+	for _, repo := range []interface{ Init() error }{r.meetings, r.venues, r.races, r.runners, r.results, r.prices, r.changes, r.revisions} {
+		if err := repo.Init(); err != nil {
+			return nil, err
+		}
+	}
+
+	// For test/example purposes, we seed the DB with some dummy races.
+	if err := seeder.Seed(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	"git.neds.sh/matty/entain/racing/db"
+)
+
+const (
+	// actorMetadata is the request metadata naming who makes a change, the gateway fills it from the X-Actor header.
+	actorMetadata = "x-actor"
+	// reasonMetadata is the request metadata saying why a change is made, the gateway fills it from the X-Reason header.
+	reasonMetadata = "x-reason"
+	// anonymousActor is recorded for changes whose request doesn't name an actor.
+	anonymousActor = "anonymous"
+)
+
+// auditFromContext returns who makes the change a request asks for and why, as given by its metadata.
+func auditFromContext(ctx context.Context) db.Audit {
+	audit := db.Audit{Actor: anonymousActor}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return audit
+	}
+
+	if actor := md.Get(actorMetadata); len(actor) > 0 && actor[0] != "" {
+		audit.Actor = actor[0]
+	}
+
+	if reason := md.Get(reasonMetadata); len(reason) > 0 {
+		audit.Reason = reason[0]
+	}
+
+	return audit
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/db"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+func TestPinnedClock(t *testing.T) {
+	racingDB, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "racing.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer racingDB.Close()
+
+	// Every repository reads the same pinned clock, which the test moves across the advertised start.
+	advertisedStart := time.Date(2026, time.October, 18, 5, 15, 0, 0, time.UTC)
+	var now time.Time
+	clock := func() time.Time { return now }
+
+	var (
+		meetingsRepo  = db.NewMeetingsRepo(racingDB)
+		venuesRepo    = db.NewVenuesRepo(racingDB)
+		racesRepo     = db.NewRacesRepo(racingDB, clock)
+		runnersRepo   = db.NewRunnersRepo(racingDB, clock)
+		resultsRepo   = db.NewResultsRepo(racingDB, clock)
+		pricesRepo    = db.NewPricesRepo(racingDB, clock)
+		changesRepo   = db.NewChangesRepo(racingDB)
+		revisionsRepo = db.NewRevisionsRepo(racingDB)
+	)
+
+	for _, repo := range []interface{ Init() error }{meetingsRepo, venuesRepo, racesRepo, runnersRepo, resultsRepo, pricesRepo, changesRepo, revisionsRepo} {
+		if err := repo.Init(); err != nil {
+			if strings.Contains(err.Error(), "sqlite_fts5") {
+				t.Skip("search needs the sqlite driver built with -tags sqlite_fts5")
+			}
+
+			t.Fatal(err)
+		}
+	}
+
+	now = advertisedStart.Add(-time.Hour)
+	_, err = db.NewImportRepo(racingDB, clock).Import(
+		[]*racing.Meeting{{Id: 1, Venue: "Randwick", Country: "AUS", RaceType: racing.RaceType_RACE_TYPE_THOROUGHBRED, Date: "2026-10-18"}},
+		[]*racing.Race{{Id: 1, MeetingId: 1, Name: "Clock Stakes", Number: 1, Visible: true, AdvertisedStartTime: timestamppb.New(advertisedStart)}},
+		db.Audit{Actor: "test"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for saddle := 1; saddle <= 3; saddle++ {
+		if _, err := racingDB.Exec(`INSERT INTO runners(id, race_id, saddle_number, barrier, name, jockey, trainer, weight) VALUES (?, 1, ?, ?, 'Runner', 'Jockey', 'Trainer', 56)`, 100+saddle, saddle, saddle); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := NewRacingService(racesRepo, meetingsRepo, runnersRepo, resultsRepo, pricesRepo, changesRepo, revisionsRepo, venuesRepo, 100)
+	ctx := context.Background()
+
+	expectStatus := func(t *testing.T, expected racing.RaceStatus) {
+		list, err := s.ListRaces(ctx, &racing.ListRacesRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(list.Races) != 1 || list.Races[0].Status != expected {
+			t.Errorf("Unexpected listed races %v (expected a %v race)", list.Races, expected)
+		}
+
+		got, err := s.GetRace(ctx, &racing.GetRaceRequest{Id: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.Race.Status != expected {
+			t.Errorf("Unexpected status %v (expected %v)", got.Race.Status, expected)
+		}
+	}
+
+	record := func() error {
+		_, err := s.RecordRaceResult(ctx, &racing.RecordRaceResultRequest{RaceId: 1, Placings: []*racing.Placing{{RunnerId: 102, Position: 1}}})
+		return err
+	}
+
+	t.Run("Before the advertised start", func(t *testing.T) {
+		now = advertisedStart.Add(-time.Minute)
+		expectStatus(t, racing.RaceStatus_RACE_STATUS_OPEN)
+
+		if err := record(); status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Unexpected error recording a result of an open race: %v", err)
+		}
+
+		scratched, err := s.ScratchRunner(ctx, &racing.ScratchRunnerRequest{Id: 101})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !scratched.Runner.Scratched || !scratched.Runner.ScratchTime.AsTime().Equal(now) {
+			t.Errorf("Unexpected scratched runner %v (expected scratching at %s)", scratched.Runner, now)
+		}
+	})
+
+	t.Run("After the advertised start", func(t *testing.T) {
+		// The clock reads in a time zone ahead of UTC, as it does on a host outside UTC.
+		now = advertisedStart.Add(time.Minute).In(time.FixedZone("AEDT", 11*60*60))
+		expectStatus(t, racing.RaceStatus_RACE_STATUS_CLOSED)
+
+		if _, err := s.ScratchRunner(ctx, &racing.ScratchRunnerRequest{Id: 103}); status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Unexpected error scratching from a closed race: %v", err)
+		}
+
+		if err := record(); err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := s.GetRaceResult(ctx, &racing.GetRaceResultRequest{RaceId: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !result.Result.RecordedTime.AsTime().Equal(now) {
+			t.Errorf("Unexpected recorded time %s (expected %s)", result.Result.RecordedTime.AsTime(), now)
+		}
+
+		// Stored times are compared as text, so they are all kept in UTC.
+		var stored string
+		if err := racingDB.QueryRow(`SELECT CAST(recorded_time AS TEXT) FROM race_results WHERE race_id = 1`).Scan(&stored); err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.HasSuffix(stored, "+00:00") {
+			t.Errorf("Unexpected stored recorded time %s (expected it in UTC)", stored)
+		}
+
+		expectStatus(t, racing.RaceStatus_RACE_STATUS_FINAL)
+	})
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"log"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"git.neds.sh/matty/entain/racing/db"
+)
+
+// CloseStartedRaces stores open races as closed once their advertised start time passes, checking every interval
+// until ctx is done. It runs once per server, so when a race is recorded as closed doesn't depend on who is reading.
+// WatchRaces streams pick the closes up from the change log.
+func CloseStartedRaces(ctx context.Context, racesRepo db.RacesRepo, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := racesRepo.CloseStarted(); err != nil {
+			log.Printf("closing started races failed: %v\n", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+// validateReadMask checks every path of a read mask names a Race field.
+func validateReadMask(mask *fieldmaskpb.FieldMask) error {
+	for _, path := range mask.GetPaths() {
+		if _, err := fieldmaskpb.New(&racing.Race{}, path); err != nil {
+			return invalidArgument("read_mask", "read_mask path %q is not a race field", path)
+		}
+	}
+
+	return nil
+}
+
+// maskIncludes reports whether a read mask asks for a top level field, an empty mask asks for every field.
+func maskIncludes(mask *fieldmaskpb.FieldMask, field string) bool {
+	if len(mask.GetPaths()) == 0 {
+		return true
+	}
+
+	for _, path := range mask.GetPaths() {
+		if strings.SplitN(path, ".", 2)[0] == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyReadMask clears the fields of each race not named by the read mask, an empty mask leaves races untouched.
+func applyReadMask(mask *fieldmaskpb.FieldMask, races ...*racing.Race) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+
+	for _, race := range races {
+		prune(race.ProtoReflect(), mask.GetPaths())
+	}
+}
+
+// prune clears every populated field of msg not named by paths, paths reach into nested messages with dots.
+func prune(msg protoreflect.Message, paths []string) {
+	// nested holds the sub paths kept of each field, a nil entry keeps the whole field.
+	nested := make(map[protoreflect.Name][]string)
+	whole := make(map[protoreflect.Name]bool)
+
+	for _, path := range paths {
+		parts := strings.SplitN(path, ".", 2)
+		name := protoreflect.Name(parts[0])
+
+		if len(parts) == 1 {
+			whole[name] = true
+			continue
+		}
+
+		nested[name] = append(nested[name], parts[1])
+	}
+
+	var cleared []protoreflect.FieldDescriptor
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case whole[fd.Name()]:
+		case nested[fd.Name()] != nil:
+			prune(v.Message(), nested[fd.Name()])
+		default:
+			cleared = append(cleared, fd)
+		}
+
+		return true
+	})
+
+	// Fields are cleared once ranging is done, as the message mustn't change while it is ranged over.
+	for _, fd := range cleared {
+		msg.Clear(fd)
+	}
+}
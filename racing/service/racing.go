@@ -1,43 +1,922 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
 	"git.neds.sh/matty/entain/racing/db"
 	"git.neds.sh/matty/entain/racing/proto/racing"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Racing interface {
 	// ListRaces will return a collection of races.
 	ListRaces(ctx context.Context, in *racing.ListRacesRequest) (*racing.ListRacesResponse, error)
 
+	// ListNextToGo will return the soonest races that haven't started yet.
+	ListNextToGo(ctx context.Context, in *racing.ListNextToGoRequest) (*racing.ListNextToGoResponse, error)
+
 	// GetRace will return a race collection.
 	GetRace(ctx context.Context, in *racing.GetRaceRequest) (*racing.GetRaceResponse, error)
+
+	// BatchGetRaces will return several races by ID in request order.
+	BatchGetRaces(ctx context.Context, in *racing.BatchGetRacesRequest) (*racing.BatchGetRacesResponse, error)
+
+	// SetRaceStatus will move a race to a new status.
+	SetRaceStatus(ctx context.Context, in *racing.SetRaceStatusRequest) (*racing.SetRaceStatusResponse, error)
+
+	// CreateRace will create a race.
+	CreateRace(ctx context.Context, in *racing.CreateRaceRequest) (*racing.CreateRaceResponse, error)
+
+	// UpdateRace will update the fields of a race named by the update mask.
+	UpdateRace(ctx context.Context, in *racing.UpdateRaceRequest) (*racing.UpdateRaceResponse, error)
+
+	// DeleteRace will delete a race.
+	DeleteRace(ctx context.Context, in *racing.DeleteRaceRequest) (*racing.DeleteRaceResponse, error)
+
+	// ListMeetings will return a collection of race meetings.
+	ListMeetings(ctx context.Context, in *racing.ListMeetingsRequest) (*racing.ListMeetingsResponse, error)
+
+	// GetMeeting will return a race meeting.
+	GetMeeting(ctx context.Context, in *racing.GetMeetingRequest) (*racing.GetMeetingResponse, error)
+
+	// ListRunners will return the runners of a race.
+	ListRunners(ctx context.Context, in *racing.ListRunnersRequest) (*racing.ListRunnersResponse, error)
+
+	// ScratchRunner will withdraw a runner from its race.
+	ScratchRunner(ctx context.Context, in *racing.ScratchRunnerRequest) (*racing.ScratchRunnerResponse, error)
+
+	// RecordRaceResult will record the result of a race.
+	RecordRaceResult(ctx context.Context, in *racing.RecordRaceResultRequest) (*racing.RecordRaceResultResponse, error)
+
+	// GetRaceResult will return the result of a race.
+	GetRaceResult(ctx context.Context, in *racing.GetRaceResultRequest) (*racing.GetRaceResultResponse, error)
+
+	// GetRacePrices will return the current prices of a race.
+	GetRacePrices(ctx context.Context, in *racing.GetRacePricesRequest) (*racing.GetRacePricesResponse, error)
+
+	// ListPriceHistory will return the price history of a race.
+	ListPriceHistory(ctx context.Context, in *racing.ListPriceHistoryRequest) (*racing.ListPriceHistoryResponse, error)
+
+	// UpdatePrices will set new prices for runners in a race.
+	UpdatePrices(ctx context.Context, in *racing.UpdatePricesRequest) (*racing.UpdatePricesResponse, error)
+
+	// WatchRaces will stream changes to races.
+	WatchRaces(in *racing.WatchRacesRequest, stream racing.Racing_WatchRacesServer) error
+
+	// ListRaceRevisions will return the revision history of a race.
+	ListRaceRevisions(ctx context.Context, in *racing.ListRaceRevisionsRequest) (*racing.ListRaceRevisionsResponse, error)
+
+	// GetRaceAtRevision will return a race as it was at a revision.
+	GetRaceAtRevision(ctx context.Context, in *racing.GetRaceAtRevisionRequest) (*racing.GetRaceAtRevisionResponse, error)
+
+	// ListVenues will return a collection of racecourses.
+	ListVenues(ctx context.Context, in *racing.ListVenuesRequest) (*racing.ListVenuesResponse, error)
 }
 
 // racingService implements the Racing interface.
 type racingService struct {
-	racesRepo db.RacesRepo
+	racesRepo     db.RacesRepo
+	meetingsRepo  db.MeetingsRepo
+	runnersRepo   db.RunnersRepo
+	resultsRepo   db.ResultsRepo
+	pricesRepo    db.PricesRepo
+	changesRepo   db.ChangesRepo
+	revisionsRepo db.RevisionsRepo
+	venuesRepo    db.VenuesRepo
+	feed          *raceFeed
+	maxBatchSize  int
 }
 
 // NewRacingService instantiates and returns a new racingService.
-func NewRacingService(racesRepo db.RacesRepo) Racing {
-	return &racingService{racesRepo}
+// maxBatchSize caps the number of ids a BatchGetRaces call can ask for.
+func NewRacingService(racesRepo db.RacesRepo, meetingsRepo db.MeetingsRepo, runnersRepo db.RunnersRepo, resultsRepo db.ResultsRepo, pricesRepo db.PricesRepo, changesRepo db.ChangesRepo, revisionsRepo db.RevisionsRepo, venuesRepo db.VenuesRepo, maxBatchSize int) Racing {
+	return &racingService{racesRepo, meetingsRepo, runnersRepo, resultsRepo, pricesRepo, changesRepo, revisionsRepo, venuesRepo, newRaceFeed(), maxBatchSize}
 }
 
 func (s *racingService) ListRaces(ctx context.Context, in *racing.ListRacesRequest) (*racing.ListRacesResponse, error) {
-	races, err := s.racesRepo.List(in.Filter)
+	if in.PageSize < 0 {
+		return nil, invalidArgument("page_size", "page_size must not be negative")
+	}
+
+	if err := validateTimeRange("filter.advertised_start", in.GetFilter().GetAdvertisedStartFrom(), in.GetFilter().GetAdvertisedStartTo()); err != nil {
+		return nil, err
+	}
+
+	if err := validateVisibility(in.GetFilter().GetVisibility()); err != nil {
+		return nil, err
+	}
+
+	if err := validateReadMask(in.ReadMask); err != nil {
+		return nil, err
+	}
+
+	if err := validateAsOf(in.AsOf); err != nil {
+		return nil, err
+	}
+
+	races, nextPageToken, err := s.racesRepo.List(in)
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrInvalidPageToken):
+			return nil, invalidArgument("page_token", "%v", err)
+		case errors.Is(err, db.ErrInvalidSort):
+			return nil, invalidArgument("filter.sort", "%v", err)
+		case errors.Is(err, db.ErrInvalidQuery):
+			// The message quotes the query, so it mustn't be used as a format.
+			return nil, invalidArgument("filter.query", "%v", err)
+		}
+
+		return nil, err
+	}
+
+	if in.IncludeMeeting && maskIncludes(in.ReadMask, "meeting") {
+		if err := s.embedMeetings(races); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.IncludeResult && maskIncludes(in.ReadMask, "result") {
+		if err := s.embedResults(races); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.embedLocalTimes(races); err != nil {
+		return nil, err
+	}
+
+	applyReadMask(in.ReadMask, races...)
+
+	return &racing.ListRacesResponse{Races: races, NextPageToken: nextPageToken}, nil
+}
+
+// embedMeetings sets the meeting of each race, fetching every meeting in a single query.
+func (s *racingService) embedMeetings(races []*racing.Race) error {
+	var ids []int64
+	seen := make(map[int64]bool)
+
+	for _, race := range races {
+		if !seen[race.MeetingId] {
+			seen[race.MeetingId] = true
+			ids = append(ids, race.MeetingId)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	meetings, err := s.meetingsRepo.List(&racing.ListMeetingsRequestFilter{Ids: ids})
 	if err != nil {
+		return err
+	}
+
+	byID := make(map[int64]*racing.Meeting, len(meetings))
+	for _, meeting := range meetings {
+		byID[meeting.Id] = meeting
+	}
+
+	for _, race := range races {
+		race.Meeting = byID[race.MeetingId]
+	}
+
+	return nil
+}
+
+// embedLocalTimes sets the local advertised start time of each race, in the time zone of its meeting's venue.
+// Races read without their advertised start time, or at a venue that isn't known, are left without one.
+func (s *racingService) embedLocalTimes(races []*racing.Race) error {
+	var ids []int64
+	seen := make(map[int64]bool)
+
+	for _, race := range races {
+		if race.AdvertisedStartTime != nil && !seen[race.MeetingId] {
+			seen[race.MeetingId] = true
+			ids = append(ids, race.MeetingId)
+		}
+	}
+
+	locations, err := s.venuesRepo.Locations(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, race := range races {
+		if location, ok := locations[race.MeetingId]; ok && race.AdvertisedStartTime != nil {
+			race.LocalAdvertisedStartTime = race.AdvertisedStartTime.AsTime().In(location).Format(time.RFC3339)
+		}
+	}
+
+	return nil
+}
+
+const (
+	// defaultNextToGoLimit is the number of next to go races returned when the request leaves limit unset.
+	defaultNextToGoLimit = 5
+	// maxNextToGoLimit caps the number of next to go races a request can ask for.
+	maxNextToGoLimit = 50
+)
+
+func (s *racingService) ListNextToGo(ctx context.Context, in *racing.ListNextToGoRequest) (*racing.ListNextToGoResponse, error) {
+	if in.Limit < 0 {
+		return nil, invalidArgument("limit", "limit must not be negative")
+	}
+
+	for _, raceType := range in.GetFilter().GetRaceTypes() {
+		if _, ok := racing.RaceType_name[int32(raceType)]; !ok || raceType == racing.RaceType_RACE_TYPE_UNSPECIFIED {
+			return nil, invalidArgument("filter.race_types", "race_types contains %v which is not a race type", raceType)
+		}
+	}
+
+	if err := validateAsOf(in.AsOf); err != nil {
+		return nil, err
+	}
+
+	limit := int(in.Limit)
+	switch {
+	case limit == 0:
+		limit = defaultNextToGoLimit
+	case limit > maxNextToGoLimit:
+		limit = maxNextToGoLimit
+	}
+
+	races, err := s.racesRepo.NextToGo(in.Filter, limit, in.AsOf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.embedLocalTimes(races); err != nil {
 		return nil, err
 	}
 
-	return &racing.ListRacesResponse{Races: races}, nil
+	return &racing.ListNextToGoResponse{Races: races}, nil
 }
 
 func (s *racingService) GetRace(ctx context.Context, req *racing.GetRaceRequest) (*racing.GetRaceResponse, error) {
+	if err := validateReadMask(req.ReadMask); err != nil {
+		return nil, err
+	}
+
+	if err := validateAsOf(req.AsOf); err != nil {
+		return nil, err
+	}
+
 	race, err := s.racesRepo.GetRace(req)
 	if err != nil {
+		if errors.Is(err, db.ErrRaceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d not found", req.Id)
+		}
+
+		return nil, err
+	}
+
+	if req.IncludeRunners && maskIncludes(req.ReadMask, "runners") {
+		race.Runners, err = s.runnersRepo.List([]int64{race.Id}, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.embedLocalTimes([]*racing.Race{race}); err != nil {
 		return nil, err
 	}
 
+	applyReadMask(req.ReadMask, race)
+
 	return &racing.GetRaceResponse{Race: race}, nil
 }
+
+func (s *racingService) BatchGetRaces(ctx context.Context, in *racing.BatchGetRacesRequest) (*racing.BatchGetRacesResponse, error) {
+	if len(in.Ids) > s.maxBatchSize {
+		return nil, invalidArgument("ids", "ids must not hold more than %d ids", s.maxBatchSize)
+	}
+
+	if err := validateReadMask(in.ReadMask); err != nil {
+		return nil, err
+	}
+
+	found, err := s.racesRepo.BatchGet(in.Ids, in.ReadMask)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &racing.BatchGetRacesResponse{}
+	for _, id := range in.Ids {
+		race, ok := found[id]
+		if !ok {
+			resp.NotFoundIds = append(resp.NotFoundIds, id)
+			continue
+		}
+
+		resp.Races = append(resp.Races, race)
+	}
+
+	if err := s.embedLocalTimes(resp.Races); err != nil {
+		return nil, err
+	}
+
+	applyReadMask(in.ReadMask, resp.Races...)
+
+	return resp, nil
+}
+
+func (s *racingService) SetRaceStatus(ctx context.Context, in *racing.SetRaceStatusRequest) (*racing.SetRaceStatusResponse, error) {
+	if _, ok := racing.RaceStatus_name[int32(in.Status)]; !ok || in.Status == racing.RaceStatus_RACE_STATUS_UNSPECIFIED {
+		return nil, invalidArgument("status", "status %v is not a race status", in.Status)
+	}
+
+	race, err := s.racesRepo.SetStatus(in.Id, in.Status, auditFromContext(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRaceNotFound):
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.Id)
+		case errors.Is(err, db.ErrIllegalStatusTransition):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, err
+	}
+	s.feed.notify()
+
+	if err := s.embedLocalTimes([]*racing.Race{race}); err != nil {
+		return nil, err
+	}
+
+	return &racing.SetRaceStatusResponse{Race: race}, nil
+}
+
+func (s *racingService) CreateRace(ctx context.Context, in *racing.CreateRaceRequest) (*racing.CreateRaceResponse, error) {
+	if in.Race == nil {
+		return nil, invalidArgument("race", "race must be set")
+	}
+
+	if err := s.validateRace(in.Race, updatableRaceFields); err != nil {
+		return nil, err
+	}
+
+	race, err := s.racesRepo.Create(in.Race, auditFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.feed.notify()
+
+	if err := s.embedLocalTimes([]*racing.Race{race}); err != nil {
+		return nil, err
+	}
+
+	return &racing.CreateRaceResponse{Race: race}, nil
+}
+
+func (s *racingService) UpdateRace(ctx context.Context, in *racing.UpdateRaceRequest) (*racing.UpdateRaceResponse, error) {
+	if in.Race == nil {
+		return nil, invalidArgument("race", "race must be set")
+	}
+
+	fields, err := raceUpdateFields(in.Race, in.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := parseEtag("race.etag", in.Race.Etag)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateRace(in.Race, fields); err != nil {
+		return nil, err
+	}
+
+	race, err := s.racesRepo.Update(in.Race, fields, version, auditFromContext(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRaceNotFound):
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.Race.Id)
+		case errors.Is(err, db.ErrEtagMismatch):
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+
+		return nil, err
+	}
+	s.feed.notify()
+
+	if err := s.embedLocalTimes([]*racing.Race{race}); err != nil {
+		return nil, err
+	}
+
+	return &racing.UpdateRaceResponse{Race: race}, nil
+}
+
+func (s *racingService) DeleteRace(ctx context.Context, in *racing.DeleteRaceRequest) (*racing.DeleteRaceResponse, error) {
+	version, err := parseEtag("etag", in.Etag)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.racesRepo.Delete(in.Id, version, auditFromContext(ctx)); err != nil {
+		switch {
+		case errors.Is(err, db.ErrRaceNotFound):
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.Id)
+		case errors.Is(err, db.ErrEtagMismatch):
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+
+		return nil, err
+	}
+	s.feed.notify()
+
+	return &racing.DeleteRaceResponse{}, nil
+}
+
+func (s *racingService) ListRaceRevisions(ctx context.Context, in *racing.ListRaceRevisionsRequest) (*racing.ListRaceRevisionsResponse, error) {
+	revisions, err := s.revisionsRepo.List(in.RaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every race ever stored has a revision, deleted ones included, so a race without any never existed.
+	if len(revisions) == 0 {
+		return nil, status.Errorf(codes.NotFound, "race %d not found", in.RaceId)
+	}
+
+	return &racing.ListRaceRevisionsResponse{Revisions: revisions}, nil
+}
+
+func (s *racingService) GetRaceAtRevision(ctx context.Context, in *racing.GetRaceAtRevisionRequest) (*racing.GetRaceAtRevisionResponse, error) {
+	race, err := s.revisionsRepo.Get(in.RaceId, in.Revision)
+	if err != nil {
+		if errors.Is(err, db.ErrRevisionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d has no revision %d", in.RaceId, in.Revision)
+		}
+
+		return nil, err
+	}
+
+	if err := s.embedLocalTimes([]*racing.Race{race}); err != nil {
+		return nil, err
+	}
+
+	return &racing.GetRaceAtRevisionResponse{Race: race}, nil
+}
+
+// updatableRaceFields are the race fields written by CreateRace and UpdateRace, the rest are assigned by the service.
+var updatableRaceFields = []string{"meeting_id", "name", "number", "visible", "advertised_start_time"}
+
+// raceUpdateFields returns the fields an update writes, every populated updatable field when the mask is empty.
+// id and etag identify the race rather than change it, so they are skipped.
+func raceUpdateFields(race *racing.Race, mask *fieldmaskpb.FieldMask) ([]string, error) {
+	updatable := make(map[string]bool, len(updatableRaceFields))
+	for _, field := range updatableRaceFields {
+		updatable[field] = true
+	}
+
+	if len(mask.GetPaths()) == 0 {
+		var fields []string
+		msg := race.ProtoReflect()
+		for _, field := range updatableRaceFields {
+			if msg.Has(msg.Descriptor().Fields().ByName(protoreflect.Name(field))) {
+				fields = append(fields, field)
+			}
+		}
+
+		if len(fields) == 0 {
+			return nil, invalidArgument("update_mask", "update_mask is empty and race has no updatable field set")
+		}
+
+		return fields, nil
+	}
+
+	var fields []string
+	seen := make(map[string]bool)
+	for _, path := range mask.GetPaths() {
+		switch {
+		case path == "id" || path == "etag" || seen[path]:
+			continue
+		case path == "status":
+			return nil, invalidArgument("update_mask", "status can't be updated, races move through SetRaceStatus")
+		case !updatable[path]:
+			return nil, invalidArgument("update_mask", "update_mask path %q is not an updatable race field", path)
+		}
+
+		seen[path] = true
+		fields = append(fields, path)
+	}
+
+	if len(fields) == 0 {
+		return nil, invalidArgument("update_mask", "update_mask names no updatable race field")
+	}
+
+	return fields, nil
+}
+
+// validateRace checks the given fields of a race about to be written.
+func (s *racingService) validateRace(race *racing.Race, fields []string) error {
+	for _, field := range fields {
+		switch field {
+		case "meeting_id":
+			if _, err := s.meetingsRepo.Get(race.MeetingId); err != nil {
+				if errors.Is(err, db.ErrMeetingNotFound) {
+					return invalidArgument("race.meeting_id", "meeting %d not found", race.MeetingId)
+				}
+
+				return err
+			}
+		case "name":
+			if strings.TrimSpace(race.Name) == "" {
+				return invalidArgument("race.name", "name must not be empty")
+			}
+		case "number":
+			if race.Number <= 0 {
+				return invalidArgument("race.number", "number must be positive")
+			}
+		case "advertised_start_time":
+			if race.AdvertisedStartTime == nil || race.AdvertisedStartTime.CheckValid() != nil {
+				return invalidArgument("race.advertised_start_time", "advertised_start_time must be a valid timestamp")
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseEtag returns the race version an etag stands for, zero when the etag is empty.
+func parseEtag(field, etag string) (int64, error) {
+	if etag == "" {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, invalidArgument(field, "etag %q was not issued for a race", etag)
+	}
+
+	return version, nil
+}
+
+// embedResults sets the result of each race that has one recorded.
+func (s *racingService) embedResults(races []*racing.Race) error {
+	ids := make([]int64, 0, len(races))
+	for _, race := range races {
+		ids = append(ids, race.Id)
+	}
+
+	results, err := s.resultsRepo.List(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, race := range races {
+		race.Result = results[race.Id]
+	}
+
+	return nil
+}
+
+func (s *racingService) ListMeetings(ctx context.Context, in *racing.ListMeetingsRequest) (*racing.ListMeetingsResponse, error) {
+	for _, raceType := range in.GetFilter().GetRaceTypes() {
+		if _, ok := racing.RaceType_name[int32(raceType)]; !ok || raceType == racing.RaceType_RACE_TYPE_UNSPECIFIED {
+			return nil, invalidArgument("filter.race_types", "race_types contains %v which is not a race type", raceType)
+		}
+	}
+
+	if date := in.GetFilter().GetDate(); date != "" {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return nil, invalidArgument("filter.date", "date %q is not formatted as YYYY-MM-DD", date)
+		}
+	}
+
+	meetings, err := s.meetingsRepo.List(in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.ListMeetingsResponse{Meetings: meetings}, nil
+}
+
+func (s *racingService) GetMeeting(ctx context.Context, in *racing.GetMeetingRequest) (*racing.GetMeetingResponse, error) {
+	meeting, err := s.meetingsRepo.Get(in.Id)
+	if err != nil {
+		if errors.Is(err, db.ErrMeetingNotFound) {
+			return nil, status.Errorf(codes.NotFound, "meeting %d not found", in.Id)
+		}
+
+		return nil, err
+	}
+
+	return &racing.GetMeetingResponse{Meeting: meeting}, nil
+}
+
+func (s *racingService) ListVenues(ctx context.Context, in *racing.ListVenuesRequest) (*racing.ListVenuesResponse, error) {
+	venues, err := s.venuesRepo.List(in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.ListVenuesResponse{Venues: venues}, nil
+}
+
+func (s *racingService) ListRunners(ctx context.Context, in *racing.ListRunnersRequest) (*racing.ListRunnersResponse, error) {
+	if _, err := s.getRace(in.RaceId); err != nil {
+		return nil, err
+	}
+
+	runners, err := s.runnersRepo.List([]int64{in.RaceId}, in.ExcludeScratched)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.ListRunnersResponse{Runners: runners}, nil
+}
+
+func (s *racingService) ScratchRunner(ctx context.Context, in *racing.ScratchRunnerRequest) (*racing.ScratchRunnerResponse, error) {
+	runner, err := s.runnersRepo.Get(in.Id)
+	if err != nil {
+		if errors.Is(err, db.ErrRunnerNotFound) {
+			return nil, status.Errorf(codes.NotFound, "runner %d not found", in.Id)
+		}
+
+		return nil, err
+	}
+
+	if runner.Scratched {
+		return &racing.ScratchRunnerResponse{Runner: runner}, nil
+	}
+
+	race, err := s.getRace(runner.RaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Runners can only be withdrawn before the race jumps.
+	if race.Status != racing.RaceStatus_RACE_STATUS_OPEN && race.Status != racing.RaceStatus_RACE_STATUS_SUSPENDED {
+		return nil, status.Errorf(codes.FailedPrecondition, "race %d is %v, runners can only be scratched from open or suspended races", race.Id, race.Status)
+	}
+
+	runner, err = s.runnersRepo.Scratch(in.Id, auditFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.feed.notify()
+
+	return &racing.ScratchRunnerResponse{Runner: runner}, nil
+}
+
+func (s *racingService) RecordRaceResult(ctx context.Context, in *racing.RecordRaceResultRequest) (*racing.RecordRaceResultResponse, error) {
+	protestStatus := in.ProtestStatus
+	if protestStatus == racing.ProtestStatus_PROTEST_STATUS_UNSPECIFIED {
+		protestStatus = racing.ProtestStatus_PROTEST_STATUS_NONE
+	}
+
+	if _, ok := racing.ProtestStatus_name[int32(protestStatus)]; !ok {
+		return nil, invalidArgument("protest_status", "protest_status %v is not a protest status", in.ProtestStatus)
+	}
+
+	if _, err := s.getRace(in.RaceId); err != nil {
+		return nil, err
+	}
+
+	runners, err := s.runnersRepo.List([]int64{in.RaceId}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePlacings(in.Placings, runners); err != nil {
+		return nil, err
+	}
+
+	// A lodged protest can still change the placings, so the race only becomes final once it is decided.
+	raceStatus := racing.RaceStatus_RACE_STATUS_FINAL
+	if protestStatus == racing.ProtestStatus_PROTEST_STATUS_LODGED {
+		raceStatus = racing.RaceStatus_RACE_STATUS_INTERIM
+	}
+
+	result, err := s.resultsRepo.Record(&racing.RaceResult{
+		RaceId:        in.RaceId,
+		Placings:      in.Placings,
+		ProtestStatus: protestStatus,
+	}, raceStatus, auditFromContext(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRaceNotFound):
+			return nil, status.Errorf(codes.NotFound, "race %d not found", in.RaceId)
+		case errors.Is(err, db.ErrIllegalStatusTransition):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, err
+	}
+	s.feed.notify()
+
+	return &racing.RecordRaceResultResponse{Result: result}, nil
+}
+
+func (s *racingService) GetRaceResult(ctx context.Context, in *racing.GetRaceResultRequest) (*racing.GetRaceResultResponse, error) {
+	result, err := s.resultsRepo.Get(in.RaceId)
+	if err != nil {
+		if errors.Is(err, db.ErrResultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no result recorded for race %d", in.RaceId)
+		}
+
+		return nil, err
+	}
+
+	return &racing.GetRaceResultResponse{Result: result}, nil
+}
+
+func (s *racingService) GetRacePrices(ctx context.Context, in *racing.GetRacePricesRequest) (*racing.GetRacePricesResponse, error) {
+	if _, err := s.getRace(in.RaceId); err != nil {
+		return nil, err
+	}
+
+	prices, err := s.pricesRepo.Current(in.RaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.GetRacePricesResponse{Prices: prices}, nil
+}
+
+func (s *racingService) ListPriceHistory(ctx context.Context, in *racing.ListPriceHistoryRequest) (*racing.ListPriceHistoryResponse, error) {
+	if _, err := s.getRace(in.RaceId); err != nil {
+		return nil, err
+	}
+
+	prices, err := s.pricesRepo.History(in.RaceId, in.RunnerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.ListPriceHistoryResponse{Prices: prices}, nil
+}
+
+func (s *racingService) UpdatePrices(ctx context.Context, in *racing.UpdatePricesRequest) (*racing.UpdatePricesResponse, error) {
+	race, err := s.getRace(in.RaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fixed odds are only offered until the race jumps.
+	if race.Status != racing.RaceStatus_RACE_STATUS_OPEN && race.Status != racing.RaceStatus_RACE_STATUS_SUSPENDED {
+		return nil, status.Errorf(codes.FailedPrecondition, "race %d is %v, prices can only be updated for open or suspended races", race.Id, race.Status)
+	}
+
+	runners, err := s.runnersRepo.List([]int64{in.RaceId}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePrices(in.Prices, runners); err != nil {
+		return nil, err
+	}
+
+	if err := s.pricesRepo.Update(in.RaceId, in.Prices); err != nil {
+		return nil, err
+	}
+
+	prices, err := s.pricesRepo.Current(in.RaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &racing.UpdatePricesResponse{Prices: prices}, nil
+}
+
+// getRace returns a race by id, or a NotFound error when it doesn't exist.
+func (s *racingService) getRace(id int64) (*racing.Race, error) {
+	race, err := s.racesRepo.GetRace(&racing.GetRaceRequest{Id: id})
+	if err != nil {
+		if errors.Is(err, db.ErrRaceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "race %d not found", id)
+		}
+
+		return nil, err
+	}
+
+	return race, nil
+}
+
+// validatePrices checks price updates against the field of the race, decimal odds must be above 1 and place can't pay more than win.
+func validatePrices(prices []*racing.RunnerPrice, runners []*racing.Runner) error {
+	if len(prices) == 0 {
+		return invalidArgument("prices", "prices must not be empty")
+	}
+
+	field := make(map[int64]*racing.Runner, len(runners))
+	for _, runner := range runners {
+		field[runner.Id] = runner
+	}
+
+	priced := make(map[int64]bool, len(prices))
+	for i, price := range prices {
+		runner, ok := field[price.RunnerId]
+		switch {
+		case !ok:
+			return invalidArgument(fmt.Sprintf("prices[%d].runner_id", i), "runner %d is not entered in the race", price.RunnerId)
+		case runner.Scratched:
+			return invalidArgument(fmt.Sprintf("prices[%d].runner_id", i), "runner %d was scratched", price.RunnerId)
+		case priced[price.RunnerId]:
+			return invalidArgument(fmt.Sprintf("prices[%d].runner_id", i), "runner %d is priced more than once", price.RunnerId)
+		case price.Win <= 1:
+			return invalidArgument(fmt.Sprintf("prices[%d].win", i), "prices of runner %d must be greater than 1", price.RunnerId)
+		case price.Place <= 1:
+			return invalidArgument(fmt.Sprintf("prices[%d].place", i), "prices of runner %d must be greater than 1", price.RunnerId)
+		case price.Place > price.Win:
+			return invalidArgument(fmt.Sprintf("prices[%d].place", i), "place price of runner %d must not exceed its win price", price.RunnerId)
+		}
+		priced[price.RunnerId] = true
+	}
+
+	return nil
+}
+
+// validatePlacings checks placings against the field of the race.
+// Positions follow standard competition ranking, so a dead heat for first is followed by third.
+func validatePlacings(placings []*racing.Placing, runners []*racing.Runner) error {
+	if len(placings) == 0 {
+		return invalidArgument("placings", "placings must not be empty")
+	}
+
+	field := make(map[int64]*racing.Runner, len(runners))
+	for _, runner := range runners {
+		field[runner.Id] = runner
+	}
+
+	// Walk the placings in finishing order while keeping their request index for field violations.
+	order := make([]int, len(placings))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return placings[order[i]].Position < placings[order[j]].Position })
+
+	placed := make(map[int64]bool, len(placings))
+	for i, index := range order {
+		placing := placings[index]
+		runner, ok := field[placing.RunnerId]
+		switch {
+		case !ok:
+			return invalidArgument(fmt.Sprintf("placings[%d].runner_id", index), "runner %d is not entered in the race", placing.RunnerId)
+		case runner.Scratched:
+			return invalidArgument(fmt.Sprintf("placings[%d].runner_id", index), "runner %d was scratched", placing.RunnerId)
+		case placed[placing.RunnerId]:
+			return invalidArgument(fmt.Sprintf("placings[%d].runner_id", index), "runner %d is placed more than once", placing.RunnerId)
+		case placing.Margin < 0:
+			return invalidArgument(fmt.Sprintf("placings[%d].margin", index), "margin of runner %d must not be negative", placing.RunnerId)
+		}
+		placed[placing.RunnerId] = true
+
+		deadHeat := i > 0 && placing.Position == placings[order[i-1]].Position
+		switch {
+		case !deadHeat && placing.Position != int64(i+1):
+			return invalidArgument(fmt.Sprintf("placings[%d].position", index), "runner %d has position %d, expected %d", placing.RunnerId, placing.Position, i+1)
+		case (deadHeat || i == 0) && placing.Margin != 0:
+			return invalidArgument(fmt.Sprintf("placings[%d].margin", index), "runner %d must have no margin as the winner or in a dead heat", placing.RunnerId)
+		}
+	}
+
+	return nil
+}
+
+// validateVisibility checks a filter's visibility is one of the Visibility values.
+func validateVisibility(visibility racing.Visibility) error {
+	if _, ok := racing.Visibility_name[int32(visibility)]; !ok {
+		return invalidArgument("filter.visibility", "visibility %v is not a visibility", visibility)
+	}
+
+	return nil
+}
+
+// validateTimeRange checks the optional bounds of a time window, where from is inclusive and to exclusive.
+// field is the path of the window without its _from and _to suffixes.
+func validateTimeRange(field string, from, to *timestamppb.Timestamp) error {
+	if from != nil && from.CheckValid() != nil {
+		return invalidArgument(field+"_from", "%s_from is not a valid timestamp", field)
+	}
+
+	if to != nil && to.CheckValid() != nil {
+		return invalidArgument(field+"_to", "%s_to is not a valid timestamp", field)
+	}
+
+	if from != nil && to != nil && !from.AsTime().Before(to.AsTime()) {
+		return invalidArgument(field+"_from", "%s_from must be before %s_to", field, field)
+	}
+
+	return nil
+}
+
+// validateAsOf checks the optional instant derived fields are evaluated at.
+func validateAsOf(asOf *timestamppb.Timestamp) error {
+	if asOf != nil && asOf.CheckValid() != nil {
+		return invalidArgument("as_of", "as_of is not a valid timestamp")
+	}
+
+	return nil
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,17 +15,128 @@ import (
 )
 
 type Race struct {
-	ID                  string `json:"id"`
-	MeetingID           string `json:"meetingId"`
-	Name                string `json:"name"`
-	Number              string `json:"number"`
-	Visible             bool   `json:"visible"`
-	AdvertisedStartTime string `json:"advertisedStartTime"`
-	Status              string `json:"status"`
+	ID                  string      `json:"id"`
+	MeetingID           string      `json:"meetingId"`
+	Name                string      `json:"name"`
+	Number              string      `json:"number"`
+	Visible             bool        `json:"visible"`
+	AdvertisedStartTime string      `json:"advertisedStartTime"`
+	Status              string      `json:"status"`
+	Meeting             *Meeting    `json:"meeting"`
+	Runners             []Runner    `json:"runners"`
+	Result              *RaceResult `json:"result"`
+	Etag                string      `json:"etag"`
+
+	LocalAdvertisedStartTime string `json:"localAdvertisedStartTime"`
+}
+
+type Meeting struct {
+	ID             string `json:"id"`
+	Venue          string `json:"venue"`
+	Country        string `json:"country"`
+	RaceType       string `json:"raceType"`
+	Date           string `json:"date"`
+	TrackCondition string `json:"trackCondition"`
+	VenueID        string `json:"venueId"`
+}
+
+type listMeetingsResponse struct {
+	Meetings []Meeting `json:"meetings"`
+}
+
+type getMeetingResponse struct {
+	Meeting Meeting `json:"meeting"`
+}
+
+type Runner struct {
+	ID           string  `json:"id"`
+	RaceID       string  `json:"raceId"`
+	SaddleNumber string  `json:"saddleNumber"`
+	Barrier      string  `json:"barrier"`
+	Name         string  `json:"name"`
+	Jockey       string  `json:"jockey"`
+	Trainer      string  `json:"trainer"`
+	Weight       float64 `json:"weight"`
+	Scratched    bool    `json:"scratched"`
+	ScratchTime  string  `json:"scratchTime"`
+}
+
+type listRunnersResponse struct {
+	Runners []Runner `json:"runners"`
+}
+
+type Placing struct {
+	RunnerID string  `json:"runnerId"`
+	Position string  `json:"position"`
+	Margin   float64 `json:"margin"`
+	DeadHeat bool    `json:"deadHeat"`
+}
+
+type RaceResult struct {
+	RaceID        string    `json:"raceId"`
+	Placings      []Placing `json:"placings"`
+	ProtestStatus string    `json:"protestStatus"`
+	RecordedTime  string    `json:"recordedTime"`
+}
+
+type raceResultResponse struct {
+	Result RaceResult `json:"result"`
+}
+
+type RunnerPrice struct {
+	RunnerID    string  `json:"runnerId"`
+	Win         float64 `json:"win"`
+	Place       float64 `json:"place"`
+	UpdatedTime string  `json:"updatedTime"`
+}
+
+type pricesResponse struct {
+	Prices []RunnerPrice `json:"prices"`
+}
+
+type watchRacesResponse struct {
+	Result struct {
+		Sequence   string `json:"sequence"`
+		Kind       string `json:"kind"`
+		Race       Race   `json:"race"`
+		ChangeTime string `json:"changeTime"`
+	} `json:"result"`
+}
+
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Details []struct {
+		Type            string `json:"@type"`
+		FieldViolations []struct {
+			Field       string `json:"field"`
+			Description string `json:"description"`
+		} `json:"fieldViolations"`
+	} `json:"details"`
+}
+
+type batchGetRacesResponse struct {
+	Races       []Race   `json:"races"`
+	NotFoundIDs []string `json:"notFoundIds"`
+}
+
+type raceRevisionsResponse struct {
+	Revisions []struct {
+		Revision string `json:"revision"`
+		Kind     string `json:"kind"`
+		Actor    string `json:"actor"`
+		Reason   string `json:"reason"`
+		Changes  []struct {
+			Field    string `json:"field"`
+			OldValue string `json:"oldValue"`
+			NewValue string `json:"newValue"`
+		} `json:"changes"`
+	} `json:"revisions"`
 }
 
 type listRacesResponse struct {
-	Races []Race `json:"races"`
+	Races         []Race `json:"races"`
+	NextPageToken string `json:"nextPageToken"`
 }
 
 type listRacesTestCase struct {
@@ -54,7 +167,7 @@ const (
 	listTestCaseName5    = "Filtered visible true and advertised_start_time order by desc"
 	listTestCaseName6    = "Filtered visible true, advertised_start_time order by desc, all status is CLOSED"
 	getRaceTestCaseName1 = "Success: Valid ID"
-	getRaceTestCaseName2 = "Empty: Non-existent ID"
+	getRaceTestCaseName2 = "Not found: Non-existent ID"
 )
 
 var meetingIDs = []int{3, 8}
@@ -86,9 +199,8 @@ func TestListRaces(t *testing.T) {
 			name: listTestCaseName4,
 			url:  apiHost + "v1/list-races",
 			filter: map[string]interface{}{
-				"visible":  true,
-				"column":   "advertised_start_time",
-				"order_by": "asc",
+				"visible": true,
+				"sort":    []map[string]interface{}{{"field": "RACE_SORT_FIELD_ADVERTISED_START_TIME", "direction": "SORT_DIRECTION_ASC"}},
 			},
 			expectedLen: 54,
 		},
@@ -96,9 +208,8 @@ func TestListRaces(t *testing.T) {
 			name: listTestCaseName5,
 			url:  apiHost + "v1/list-races",
 			filter: map[string]interface{}{
-				"visible":  true,
-				"column":   "advertised_start_time",
-				"order_by": "desc",
+				"visible": true,
+				"sort":    []map[string]interface{}{{"field": "RACE_SORT_FIELD_ADVERTISED_START_TIME", "direction": "SORT_DIRECTION_DESC"}},
 			},
 			expectedLen: 54,
 		},
@@ -106,9 +217,8 @@ func TestListRaces(t *testing.T) {
 			name: listTestCaseName6,
 			url:  apiHost + "v1/list-races",
 			filter: map[string]interface{}{
-				"visible":  true,
-				"column":   "advertised_start_time",
-				"order_by": "desc",
+				"visible": true,
+				"sort":    []map[string]interface{}{{"field": "RACE_SORT_FIELD_ADVERTISED_START_TIME", "direction": "SORT_DIRECTION_DESC"}},
 			},
 			expectedLen: 54,
 		},
@@ -208,8 +318,8 @@ func TestListRaces(t *testing.T) {
 						}
 					}
 
-					if v.Status != "CLOSED" {
-						t.Errorf("Unexpected filtered response status: %v (expected %v)", v.Status, "CLOSED")
+					if v.Status != "RACE_STATUS_CLOSED" {
+						t.Errorf("Unexpected filtered response status: %v (expected %v)", v.Status, "RACE_STATUS_CLOSED")
 						return
 					}
 				}
@@ -230,22 +340,14 @@ func TestGetRace(t *testing.T) {
 				Name:      "Virginia wolves",
 				Number:    11,
 				Visible:   true,
-				Status:    "CLOSED",
+				Status:    racing.RaceStatus_RACE_STATUS_CLOSED,
 			},
 			errMessage: "",
 		},
 		{
 			name:       getRaceTestCaseName2,
 			url:        apiHost + "v1/race?id=999",
-			statusCode: http.StatusOK, // Adjust based on actual error code
-			expected: &racing.Race{
-				Id:        0,
-				MeetingId: 0,
-				Name:      "",
-				Number:    0,
-				Visible:   false,
-				Status:    "",
-			},
+			statusCode: http.StatusNotFound,
 			errMessage: "",
 		},
 	}
@@ -275,6 +377,10 @@ func TestGetRace(t *testing.T) {
 				return
 			}
 
+			if tc.expected == nil {
+				return
+			}
+
 			// Decode the response
 			var raceResp getRaceResponse
 			if err := json.NewDecoder(resp.Body).Decode(&raceResp); err != nil {
@@ -306,7 +412,7 @@ func TestGetRace(t *testing.T) {
 				Name:      raceResp.Race.Name,
 				Number:    number,
 				Visible:   raceResp.Race.Visible,
-				Status:    raceResp.Race.Status,
+				Status:    racing.RaceStatus(racing.RaceStatus_value[raceResp.Race.Status]),
 			}
 
 			// Compare actual and expected races using the compareRaces function
@@ -317,6 +423,1870 @@ func TestGetRace(t *testing.T) {
 	}
 }
 
+func TestErrorDetails(t *testing.T) {
+	body, _ := json.Marshal(map[string]interface{}{"page_size": -1})
+	resp, err := http.Post(apiHost+"v1/list-races", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+	}
+
+	var errResp errorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatalf("Failed to decode JSON response: %v", err)
+	}
+
+	if len(errResp.Details) != 1 || len(errResp.Details[0].FieldViolations) != 1 {
+		t.Fatalf("Unexpected error details: %+v", errResp.Details)
+	}
+
+	if field := errResp.Details[0].FieldViolations[0].Field; field != "page_size" {
+		t.Errorf("Unexpected field violation: %s (expected %s)", field, "page_size")
+	}
+}
+
+func TestReadMask(t *testing.T) {
+	t.Run("GetRace populates only masked fields", func(t *testing.T) {
+		resp, err := http.Get(apiHost + "v1/race?id=57&read_mask=id,name,advertised_start_time")
+		if err != nil {
+			t.Fatalf("Failed to make GET request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var raceResp getRaceResponse
+		if err := json.NewDecoder(resp.Body).Decode(&raceResp); err != nil {
+			t.Fatalf("Failed to decode JSON response: %v", err)
+		}
+
+		race := raceResp.Race
+		if race.ID != "57" || race.Name != "Virginia wolves" || race.AdvertisedStartTime == "" {
+			t.Errorf("Unexpected masked race fields: %+v", race)
+		}
+
+		if race.MeetingID != "0" || race.Number != "0" || race.Status != "RACE_STATUS_UNSPECIFIED" {
+			t.Errorf("Unexpected unmasked race fields: %+v", race)
+		}
+	})
+
+	t.Run("ListRaces populates only masked fields", func(t *testing.T) {
+		data := map[string]interface{}{
+			"filter":          map[string]interface{}{"meeting_ids": []int{8}},
+			"include_meeting": true,
+			"read_mask":       "id,status",
+		}
+		resp, err := makePostRequest(apiHost+"v1/list-races", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(resp.Races) == 0 {
+			t.Fatal("Expected races of meeting 8")
+		}
+
+		for _, v := range resp.Races {
+			if v.ID == "" || v.Status == "RACE_STATUS_UNSPECIFIED" {
+				t.Errorf("Unexpected masked race fields: %+v", v)
+			}
+
+			if v.Name != "" || v.AdvertisedStartTime != "" || v.Meeting != nil {
+				t.Errorf("Unexpected unmasked race fields: %+v", v)
+			}
+		}
+	})
+
+	t.Run("Rejects a path that isn't a race field", func(t *testing.T) {
+		resp, err := http.Get(apiHost + "v1/race?id=57&read_mask=id,jockey")
+		if err != nil {
+			t.Fatalf("Failed to make GET request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestBatchGetRaces(t *testing.T) {
+	t.Run("Returns races in request order and reports missing ids", func(t *testing.T) {
+		resp, err := http.Get(apiHost + "v1/races:batchGet?ids=57&ids=999&ids=1&ids=57")
+		if err != nil {
+			t.Fatalf("Failed to make GET request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+		}
+
+		var batchResp batchGetRacesResponse
+		if err := json.NewDecoder(resp.Body).Decode(&batchResp); err != nil {
+			t.Fatalf("Failed to decode JSON response: %v", err)
+		}
+
+		var ids []string
+		for _, race := range batchResp.Races {
+			ids = append(ids, race.ID)
+		}
+
+		if strings.Join(ids, ",") != "57,1,57" {
+			t.Errorf("Unexpected race ids: %v (expected %v)", ids, []string{"57", "1", "57"})
+		}
+
+		if len(batchResp.NotFoundIDs) != 1 || batchResp.NotFoundIDs[0] != "999" {
+			t.Errorf("Unexpected not found ids: %v (expected %v)", batchResp.NotFoundIDs, []string{"999"})
+		}
+	})
+
+	t.Run("Rejects more ids than the batch size", func(t *testing.T) {
+		query := make([]string, 101)
+		for i := range query {
+			query[i] = "ids=" + strconv.Itoa(i+1)
+		}
+
+		resp, err := http.Get(apiHost + "v1/races:batchGet?" + strings.Join(query, "&"))
+		if err != nil {
+			t.Fatalf("Failed to make GET request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestRaceLifecycle(t *testing.T) {
+	send := func(method, url string, body interface{}) (*http.Response, Race) {
+		var raw []byte
+		if body != nil {
+			raw, _ = json.Marshal(body)
+		}
+
+		req, _ := http.NewRequest(method, url, bytes.NewReader(raw))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to make %s request: %v", method, err)
+		}
+		defer resp.Body.Close()
+
+		var raceResp getRaceResponse
+		_ = json.NewDecoder(resp.Body).Decode(&raceResp)
+
+		return resp, raceResp.Race
+	}
+
+	advertisedStart := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	resp, created := send(http.MethodPost, apiHost+"v1/races", map[string]interface{}{
+		"meeting_id":            8,
+		"name":                  "Lifecycle Stakes",
+		"number":                12,
+		"advertised_start_time": advertisedStart.Format(time.RFC3339),
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected create status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+	}
+
+	if created.ID == "" || created.Name != "Lifecycle Stakes" || created.Status != "RACE_STATUS_OPEN" || created.Etag == "" || created.LocalAdvertisedStartTime == "" {
+		t.Fatalf("Unexpected created race: %+v", created)
+	}
+	raceURL := apiHost + "v1/races/" + created.ID
+
+	t.Run("Rejects an invalid race", func(t *testing.T) {
+		invalid := map[string]map[string]interface{}{
+			"missing name":    {"meeting_id": 8, "number": 1, "advertised_start_time": advertisedStart.Format(time.RFC3339)},
+			"unknown meeting": {"meeting_id": 999, "name": "Nowhere Stakes", "number": 1, "advertised_start_time": advertisedStart.Format(time.RFC3339)},
+		}
+
+		for name, body := range invalid {
+			if resp, _ := send(http.MethodPost, apiHost+"v1/races", body); resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("Unexpected status code for %s: %d (expected %d)", name, resp.StatusCode, http.StatusBadRequest)
+			}
+		}
+	})
+
+	var updated Race
+	t.Run("Updates the fields sent", func(t *testing.T) {
+		resp, updated = send(http.MethodPatch, raceURL, map[string]interface{}{"name": "Renamed Stakes", "etag": created.Etag})
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Unexpected update status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+		}
+
+		if updated.Name != "Renamed Stakes" || updated.Number != created.Number || updated.Etag == created.Etag || updated.LocalAdvertisedStartTime != created.LocalAdvertisedStartTime {
+			t.Errorf("Unexpected updated race: %+v", updated)
+		}
+	})
+
+	t.Run("Rejects a stale etag", func(t *testing.T) {
+		if resp, _ := send(http.MethodPatch, raceURL, map[string]interface{}{"name": "Stale Stakes", "etag": created.Etag}); resp.StatusCode != http.StatusConflict {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusConflict)
+		}
+
+		if resp, _ := send(http.MethodDelete, raceURL+"?etag="+created.Etag, nil); resp.StatusCode != http.StatusConflict {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusConflict)
+		}
+	})
+
+	t.Run("Rejects a status update", func(t *testing.T) {
+		if resp, _ := send(http.MethodPatch, raceURL, map[string]interface{}{"status": "RACE_STATUS_CLOSED"}); resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Deletes the race", func(t *testing.T) {
+		if resp, _ := send(http.MethodDelete, raceURL+"?etag="+updated.Etag, nil); resp.StatusCode != http.StatusOK {
+			t.Fatalf("Unexpected delete status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+		}
+
+		if resp, _ := send(http.MethodGet, apiHost+"v1/race?id="+created.ID, nil); resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusNotFound)
+		}
+	})
+}
+
+func TestAsOf(t *testing.T) {
+	beforeCreate := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	advertisedStart := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	body, _ := json.Marshal(map[string]interface{}{
+		"meeting_id":            8,
+		"name":                  "As Of Stakes",
+		"number":                12,
+		"visible":               true,
+		"advertised_start_time": advertisedStart.Format(time.RFC3339),
+	})
+	resp, err := http.Post(apiHost+"v1/races", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var created getRaceResponse
+	_ = json.NewDecoder(resp.Body).Decode(&created)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected create status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+	}
+
+	defer func() {
+		req, _ := http.NewRequest(http.MethodDelete, apiHost+"v1/races/"+created.Race.ID, nil)
+		if resp, err := http.DefaultClient.Do(req); err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	before := advertisedStart.Add(-time.Minute).Format(time.RFC3339)
+	after := advertisedStart.Add(time.Minute).Format(time.RFC3339)
+
+	t.Run("Evaluates status at the given instant", func(t *testing.T) {
+		tests := map[string]string{
+			"":     "RACE_STATUS_OPEN",
+			before: "RACE_STATUS_OPEN",
+			after:  "RACE_STATUS_CLOSED",
+		}
+
+		for asOf, expected := range tests {
+			url := apiHost + "v1/race?id=" + created.Race.ID
+			if asOf != "" {
+				url += "&as_of=" + asOf
+			}
+
+			resp, err := http.Get(url)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var raceResp getRaceResponse
+			_ = json.NewDecoder(resp.Body).Decode(&raceResp)
+			resp.Body.Close()
+
+			if raceResp.Race.Status != expected {
+				t.Errorf("Unexpected status as of %q: %s (expected %s)", asOf, raceResp.Race.Status, expected)
+			}
+		}
+	})
+
+	t.Run("Lists races next to go at the given instant", func(t *testing.T) {
+		tests := map[string]bool{
+			before: true,
+			after:  false,
+		}
+
+		for asOf, expected := range tests {
+			resp, err := makePostRequest(apiHost+"v1/list-next-to-go", map[string]interface{}{
+				"filter": map[string]interface{}{"meeting_ids": []int{8}},
+				"limit":  50,
+				"as_of":  asOf,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			found := false
+			for _, v := range resp.Races {
+				found = found || v.ID == created.Race.ID
+			}
+
+			if found != expected {
+				t.Errorf("Unexpected next to go races as of %s: %+v", asOf, resp.Races)
+			}
+		}
+	})
+
+	t.Run("Replays status changes from the revision history", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"status": "RACE_STATUS_ABANDONED"})
+		resp, err := http.Post(apiHost+"v1/races/"+created.Race.ID+":setStatus", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		tests := map[string]string{
+			"":           "RACE_STATUS_ABANDONED",
+			beforeCreate: "RACE_STATUS_OPEN",
+			before:       "RACE_STATUS_ABANDONED",
+		}
+
+		for asOf, expected := range tests {
+			url := apiHost + "v1/race?id=" + created.Race.ID
+			if asOf != "" {
+				url += "&as_of=" + asOf
+			}
+
+			resp, err := http.Get(url)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var raceResp getRaceResponse
+			_ = json.NewDecoder(resp.Body).Decode(&raceResp)
+			resp.Body.Close()
+
+			if raceResp.Race.Status != expected {
+				t.Errorf("Unexpected status as of %q: %s (expected %s)", asOf, raceResp.Race.Status, expected)
+			}
+		}
+	})
+}
+
+func TestRaceRevisions(t *testing.T) {
+	send := func(method, url, actor, reason string, body interface{}) *http.Response {
+		var raw []byte
+		if body != nil {
+			raw, _ = json.Marshal(body)
+		}
+
+		req, _ := http.NewRequest(method, url, bytes.NewReader(raw))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Actor", actor)
+		req.Header.Set("X-Reason", reason)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to make %s request: %v", method, err)
+		}
+
+		return resp
+	}
+
+	listRevisions := func(id string) raceRevisionsResponse {
+		resp, err := http.Get(apiHost + "v1/races/" + id + "/revisions")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		var revisions raceRevisionsResponse
+		_ = json.NewDecoder(resp.Body).Decode(&revisions)
+
+		return revisions
+	}
+
+	advertisedStart := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	delayedStart := advertisedStart.Add(30 * time.Minute)
+
+	resp := send(http.MethodPost, apiHost+"v1/races", "steward", "new fixture", map[string]interface{}{
+		"meeting_id":            8,
+		"name":                  "Revision Stakes",
+		"number":                12,
+		"advertised_start_time": advertisedStart.Format(time.RFC3339),
+	})
+	var created getRaceResponse
+	_ = json.NewDecoder(resp.Body).Decode(&created)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected create status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+	}
+	raceURL := apiHost + "v1/races/" + created.Race.ID
+
+	resp = send(http.MethodPatch, raceURL, "trader", "delayed start", map[string]interface{}{"advertised_start_time": delayedStart.Format(time.RFC3339)})
+	resp.Body.Close()
+	resp = send(http.MethodPost, raceURL+":setStatus", "steward", "track inspection", map[string]interface{}{"status": "RACE_STATUS_SUSPENDED"})
+	resp.Body.Close()
+	resp = send(http.MethodDelete, raceURL, "admin", "entered in error", nil)
+	resp.Body.Close()
+
+	t.Run("Records every write with its actor, reason and changed fields", func(t *testing.T) {
+		revisions := listRevisions(created.Race.ID).Revisions
+		if len(revisions) != 4 {
+			t.Fatalf("Unexpected revisions: %+v (expected 4)", revisions)
+		}
+
+		expected := []struct{ kind, actor, reason, field string }{
+			{"RACE_CHANGE_KIND_CREATED", "steward", "new fixture", "name"},
+			{"RACE_CHANGE_KIND_UPDATED", "trader", "delayed start", "advertised_start_time"},
+			{"RACE_CHANGE_KIND_STATUS_CHANGED", "steward", "track inspection", "status"},
+			{"RACE_CHANGE_KIND_DELETED", "admin", "entered in error", ""},
+		}
+
+		for k, v := range revisions {
+			want := expected[k]
+			if v.Revision != strconv.Itoa(k+1) || v.Kind != want.kind || v.Actor != want.actor || v.Reason != want.reason {
+				t.Errorf("Unexpected revision %d: %+v", k+1, v)
+			}
+
+			found := want.field == "" && len(v.Changes) == 0
+			for _, change := range v.Changes {
+				found = found || change.Field == want.field
+			}
+
+			if !found {
+				t.Errorf("Unexpected changes of revision %d: %+v (expected a change of %q)", k+1, v.Changes, want.field)
+			}
+		}
+
+		for _, change := range revisions[1].Changes {
+			if change.OldValue != advertisedStart.Format(time.RFC3339) || change.NewValue != delayedStart.Format(time.RFC3339) {
+				t.Errorf("Unexpected start time change: %+v", change)
+			}
+		}
+
+		// The race was created hidden, which the first revision records although it is the zero value.
+		recorded := make(map[string]string)
+		for _, change := range revisions[0].Changes {
+			if change.OldValue != "" {
+				t.Errorf("Unexpected old value of the first revision: %+v", change)
+			}
+
+			recorded[change.Field] = change.NewValue
+		}
+
+		if len(recorded) != 6 || recorded["visible"] != "false" {
+			t.Errorf("Unexpected changes of the first revision: %+v", revisions[0].Changes)
+		}
+	})
+
+	t.Run("Returns the race as it was at a revision", func(t *testing.T) {
+		resp, err := http.Get(raceURL + "/revisions/1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		var raceResp getRaceResponse
+		_ = json.NewDecoder(resp.Body).Decode(&raceResp)
+
+		if raceResp.Race.AdvertisedStartTime != advertisedStart.Format(time.RFC3339) || raceResp.Race.Status != "RACE_STATUS_OPEN" || raceResp.Race.Etag != "1" {
+			t.Errorf("Unexpected race at revision 1: %+v", raceResp.Race)
+		}
+	})
+
+	t.Run("Returns not found for an unknown revision", func(t *testing.T) {
+		resp, err := http.Get(raceURL + "/revisions/99")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusNotFound)
+		}
+	})
+}
+
+func TestListRacesSort(t *testing.T) {
+	t.Run("Sorts by meeting_id then advertised_start_time desc", func(t *testing.T) {
+		data := map[string]interface{}{
+			"filter": map[string]interface{}{
+				"sort": []map[string]interface{}{
+					{"field": "RACE_SORT_FIELD_MEETING_ID"},
+					{"field": "RACE_SORT_FIELD_ADVERTISED_START_TIME", "direction": "SORT_DIRECTION_DESC"},
+				},
+			},
+		}
+		resp, err := makePostRequest(apiHost+"v1/list-races", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(resp.Races) != 100 {
+			t.Fatalf("Unexpected sorted response length: %d (expected %d)", len(resp.Races), 100)
+		}
+
+		for k := 0; k+1 < len(resp.Races); k++ {
+			v, next := resp.Races[k], resp.Races[k+1]
+			meetingID1, _ := strconv.Atoi(v.MeetingID)
+			meetingID2, _ := strconv.Atoi(next.MeetingID)
+			if meetingID1 > meetingID2 {
+				t.Fatalf("Unexpected sorted response meeting_id: %d before %d", meetingID1, meetingID2)
+			}
+
+			time1, _ := time.Parse(time.RFC3339, v.AdvertisedStartTime)
+			time2, _ := time.Parse(time.RFC3339, next.AdvertisedStartTime)
+			if meetingID1 == meetingID2 && time1.Before(time2) {
+				t.Fatalf("Unexpected sorted response advertised_start_time: %v before %v", v.AdvertisedStartTime, next.AdvertisedStartTime)
+			}
+		}
+	})
+
+	invalid := map[string][]map[string]interface{}{
+		"Rejects an unspecified sort field": {{"direction": "SORT_DIRECTION_ASC"}},
+		"Rejects an unknown sort field":     {{"field": 99}},
+		"Rejects a repeated sort field":     {{"field": "RACE_SORT_FIELD_NAME"}, {"field": "RACE_SORT_FIELD_NAME"}},
+	}
+
+	for name, sort := range invalid {
+		sort := sort
+		t.Run(name, func(t *testing.T) {
+			body, _ := json.Marshal(map[string]interface{}{"filter": map[string]interface{}{"sort": sort}})
+			resp, err := http.Post(apiHost+"v1/list-races", "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestListRacesAdvertisedStartRange(t *testing.T) {
+	from := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	t.Run("Returns races starting within the window", func(t *testing.T) {
+		data := map[string]interface{}{
+			"filter": map[string]interface{}{
+				"advertised_start_from": from.Format(time.RFC3339),
+				"advertised_start_to":   to.Format(time.RFC3339),
+				"sort":                  []map[string]interface{}{{"field": "RACE_SORT_FIELD_ADVERTISED_START_TIME"}},
+			},
+		}
+		resp, err := makePostRequest(apiHost+"v1/list-races", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(resp.Races) == 0 || len(resp.Races) == 100 {
+			t.Fatalf("Unexpected windowed response length: %d", len(resp.Races))
+		}
+
+		for _, v := range resp.Races {
+			advertisedStart, _ := time.Parse(time.RFC3339, v.AdvertisedStartTime)
+			if advertisedStart.Before(from) || !advertisedStart.Before(to) {
+				t.Errorf("Unexpected race %s advertised to start at %s outside [%s, %s)", v.ID, v.AdvertisedStartTime, from, to)
+			}
+		}
+	})
+
+	t.Run("Rejects a window ending before it starts", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"filter": map[string]interface{}{
+				"advertised_start_from": to.Format(time.RFC3339),
+				"advertised_start_to":   from.Format(time.RFC3339),
+			},
+		})
+		resp, err := http.Post(apiHost+"v1/list-races", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestListRacesQuery(t *testing.T) {
+	search := func(query string) []Race {
+		resp, err := makePostRequest(apiHost+"v1/list-races", map[string]interface{}{"filter": map[string]interface{}{"query": query}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return resp.Races
+	}
+
+	t.Run("Matches meeting venues", func(t *testing.T) {
+		races := search("randw")
+		if len(races) == 0 {
+			t.Fatal("Expected races held at Randwick")
+		}
+
+		for _, v := range races {
+			if v.MeetingID != "1" {
+				t.Errorf("Unexpected race %s of meeting %s", v.ID, v.MeetingID)
+			}
+		}
+	})
+
+	t.Run("Orders matches by relevance and keeps the index in sync", func(t *testing.T) {
+		create := func(name string) Race {
+			body, _ := json.Marshal(map[string]interface{}{
+				"meeting_id":            8,
+				"name":                  name,
+				"number":                12,
+				"advertised_start_time": time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339),
+			})
+			resp, err := http.Post(apiHost+"v1/races", "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			var raceResp getRaceResponse
+			_ = json.NewDecoder(resp.Body).Decode(&raceResp)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Unexpected create status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+			}
+
+			return raceResp.Race
+		}
+
+		remove := func(id string) {
+			req, _ := http.NewRequest(http.MethodDelete, apiHost+"v1/races/"+id, nil)
+			if resp, err := http.DefaultClient.Do(req); err == nil {
+				resp.Body.Close()
+			}
+		}
+
+		// The longer name dilutes the term, so the race created later ranks first.
+		diluted := create("Quokkaville Cup Open Handicap Over Two Thousand Metres")
+		defer remove(diluted.ID)
+		exact := create("Quokkaville")
+		defer remove(exact.ID)
+
+		races := search("quokka")
+		if len(races) != 2 || races[0].ID != exact.ID || races[1].ID != diluted.ID {
+			t.Fatalf("Unexpected races for query: %+v", races)
+		}
+
+		body, _ := json.Marshal(map[string]interface{}{"name": "Renamed Plate"})
+		req, _ := http.NewRequest(http.MethodPatch, apiHost+"v1/races/"+exact.ID, bytes.NewReader(body))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if races := search("quokka"); len(races) != 1 || races[0].ID != diluted.ID {
+			t.Errorf("Unexpected races after renaming: %+v", races)
+		}
+
+		remove(diluted.ID)
+		if races := search("quokka"); len(races) != 0 {
+			t.Errorf("Unexpected races after deleting: %+v", races)
+		}
+	})
+
+	t.Run("Rejects a query without terms", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"filter": map[string]interface{}{"query": "!!"}})
+		resp, err := http.Post(apiHost+"v1/list-races", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestListNextToGo(t *testing.T) {
+	t.Run("Returns upcoming races soonest first", func(t *testing.T) {
+		data := map[string]interface{}{
+			"filter": map[string]interface{}{"race_types": []string{"RACE_TYPE_THOROUGHBRED", "RACE_TYPE_HARNESS"}},
+			"limit":  3,
+		}
+		resp, err := makePostRequest(apiHost+"v1/list-next-to-go", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(resp.Races) > 3 {
+			t.Fatalf("Unexpected next to go response length: %d (expected at most %d)", len(resp.Races), 3)
+		}
+
+		now := time.Now()
+		for k, v := range resp.Races {
+			advertisedStart, _ := time.Parse(time.RFC3339, v.AdvertisedStartTime)
+			if !advertisedStart.After(now) {
+				t.Errorf("Unexpected race %s that started at %s", v.ID, v.AdvertisedStartTime)
+			}
+
+			if v.Status != "RACE_STATUS_OPEN" && v.Status != "RACE_STATUS_SUSPENDED" {
+				t.Errorf("Unexpected race %s with status %s", v.ID, v.Status)
+			}
+
+			if k+1 < len(resp.Races) {
+				next, _ := time.Parse(time.RFC3339, resp.Races[k+1].AdvertisedStartTime)
+				if advertisedStart.After(next) {
+					t.Errorf("Unexpected race %s starting at %s before %s", v.ID, v.AdvertisedStartTime, resp.Races[k+1].AdvertisedStartTime)
+				}
+			}
+		}
+	})
+
+	invalid := map[string]map[string]interface{}{
+		"Rejects a negative limit":     {"limit": -1},
+		"Rejects an unknown race type": {"filter": map[string]interface{}{"race_types": []int{99}}},
+	}
+
+	for name, data := range invalid {
+		data := data
+		t.Run(name, func(t *testing.T) {
+			body, _ := json.Marshal(data)
+			resp, err := http.Post(apiHost+"v1/list-next-to-go", "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestListRacesIncludeMeeting(t *testing.T) {
+	data := map[string]interface{}{"filter": map[string]interface{}{"meeting_ids": meetingIDs}, "include_meeting": true}
+	resp, err := makePostRequest(apiHost+"v1/list-races", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Races) == 0 {
+		t.Fatal("Unexpected empty response")
+	}
+
+	for _, v := range resp.Races {
+		if v.Meeting == nil || v.Meeting.ID != v.MeetingID || v.Meeting.Venue == "" {
+			t.Errorf("Unexpected embedded meeting for race %s: %+v (expected meeting %s)", v.ID, v.Meeting, v.MeetingID)
+			return
+		}
+	}
+}
+
+func TestListMeetings(t *testing.T) {
+	tests := []struct {
+		name        string
+		filter      map[string]interface{}
+		expectedLen int
+	}{
+		{
+			name:        "No filtered",
+			filter:      map[string]interface{}{},
+			expectedLen: 10,
+		},
+		{
+			name:        "Filtered race type greyhound",
+			filter:      map[string]interface{}{"race_types": []string{"RACE_TYPE_GREYHOUND"}},
+			expectedLen: 2,
+		},
+		{
+			name:        "Filtered country NZL",
+			filter:      map[string]interface{}{"country": "nzl"},
+			expectedLen: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(map[string]interface{}{"filter": tt.filter})
+			resp, err := http.Post(apiHost+"v1/list-meetings", "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			var listResp listMeetingsResponse
+			if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+				t.Fatalf("Failed to decode JSON response: %v", err)
+			}
+
+			if len(listResp.Meetings) != tt.expectedLen {
+				t.Errorf("Unexpected response length: %d (expected %d)", len(listResp.Meetings), tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestGetMeeting(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		statusCode int
+		venue      string
+	}{
+		{
+			name:       "Success: Valid ID",
+			url:        apiHost + "v1/meetings/1",
+			statusCode: http.StatusOK,
+			venue:      "Randwick",
+		},
+		{
+			name:       "Not found: Non-existent ID",
+			url:        apiHost + "v1/meetings/999",
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := http.Get(tc.url)
+			if err != nil {
+				t.Fatalf("Failed to make GET request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tc.statusCode {
+				t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, tc.statusCode)
+			}
+
+			if tc.statusCode != http.StatusOK {
+				return
+			}
+
+			var meetingResp getMeetingResponse
+			if err := json.NewDecoder(resp.Body).Decode(&meetingResp); err != nil {
+				t.Fatalf("Failed to decode JSON response: %v", err)
+			}
+
+			if meetingResp.Meeting.Venue != tc.venue {
+				t.Errorf("Unexpected meeting venue: %s (expected %s)", meetingResp.Meeting.Venue, tc.venue)
+			}
+		})
+	}
+}
+
+func TestListRunners(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		statusCode  int
+		expectedLen int
+	}{
+		{
+			name:        "Success: Race runners",
+			url:         apiHost + "v1/races/57/runners",
+			statusCode:  http.StatusOK,
+			expectedLen: 9,
+		},
+		{
+			name:       "Not found: Non-existent race",
+			url:        apiHost + "v1/races/999/runners",
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := http.Get(tc.url)
+			if err != nil {
+				t.Fatalf("Failed to make GET request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tc.statusCode {
+				t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, tc.statusCode)
+			}
+
+			if tc.statusCode != http.StatusOK {
+				return
+			}
+
+			var runnersResp listRunnersResponse
+			if err := json.NewDecoder(resp.Body).Decode(&runnersResp); err != nil {
+				t.Fatalf("Failed to decode JSON response: %v", err)
+			}
+
+			if len(runnersResp.Runners) != tc.expectedLen {
+				t.Fatalf("Unexpected response length: %d (expected %d)", len(runnersResp.Runners), tc.expectedLen)
+			}
+
+			for k, v := range runnersResp.Runners {
+				if v.RaceID != "57" || v.SaddleNumber != strconv.Itoa(k+1) {
+					t.Errorf("Unexpected runner: race %s saddle %s (expected race 57 saddle %d)", v.RaceID, v.SaddleNumber, k+1)
+					return
+				}
+
+				if v.Scratched != (v.ScratchTime != "") {
+					t.Errorf("Unexpected runner %s scratch time %q for scratched %v", v.ID, v.ScratchTime, v.Scratched)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestGetRaceIncludeRunners(t *testing.T) {
+	resp, err := http.Get(apiHost + "v1/race?id=57&include_runners=true")
+	if err != nil {
+		t.Fatalf("Failed to make GET request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var raceResp getRaceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&raceResp); err != nil {
+		t.Fatalf("Failed to decode JSON response: %v", err)
+	}
+
+	if len(raceResp.Race.Runners) != 9 {
+		t.Errorf("Unexpected runners length: %d (expected %d)", len(raceResp.Race.Runners), 9)
+	}
+}
+
+func TestScratchRunner(t *testing.T) {
+	resp, err := http.Get(apiHost + "v1/races/57/runners?exclude_scratched=true")
+	if err != nil {
+		t.Fatalf("Failed to make GET request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var runnersResp listRunnersResponse
+	if err := json.NewDecoder(resp.Body).Decode(&runnersResp); err != nil {
+		t.Fatalf("Failed to decode JSON response: %v", err)
+	}
+
+	if len(runnersResp.Runners) == 0 {
+		t.Fatal("Unexpected race without runners")
+	}
+
+	tests := []struct {
+		name       string
+		url        string
+		statusCode int
+	}{
+		{
+			name:       "Rejects scratching from a closed race",
+			url:        apiHost + "v1/runners/" + runnersResp.Runners[0].ID + ":scratch",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "Not found: Non-existent runner",
+			url:        apiHost + "v1/runners/999999:scratch",
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := http.Post(tc.url, "application/json", bytes.NewReader([]byte("{}")))
+			if err != nil {
+				t.Fatalf("Failed to make POST request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tc.statusCode {
+				t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, tc.statusCode)
+			}
+		})
+	}
+
+	t.Run("Records a revision of the race", func(t *testing.T) {
+		post := func(url string, body interface{}) {
+			raw, _ := json.Marshal(body)
+			req, _ := http.NewRequest(http.MethodPost, url, bytes.NewReader(raw))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Actor", "steward")
+			req.Header.Set("X-Reason", "vet check")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Unexpected status code of %s: %d (expected %d)", url, resp.StatusCode, http.StatusOK)
+			}
+		}
+
+		races, err := makePostRequest(apiHost+"v1/list-races", map[string]interface{}{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The seeded races have jumped, so a closed one is suspended to scratch a runner and closed again.
+		for _, race := range races.Races {
+			if race.Status != "RACE_STATUS_CLOSED" {
+				continue
+			}
+
+			resp, err := http.Get(apiHost + "v1/races/" + race.ID + "/runners?exclude_scratched=true")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var runners listRunnersResponse
+			_ = json.NewDecoder(resp.Body).Decode(&runners)
+			resp.Body.Close()
+
+			if len(runners.Runners) == 0 {
+				continue
+			}
+
+			post(apiHost+"v1/races/"+race.ID+":setStatus", map[string]interface{}{"status": "RACE_STATUS_SUSPENDED"})
+			post(apiHost+"v1/runners/"+runners.Runners[0].ID+":scratch", map[string]interface{}{})
+			post(apiHost+"v1/races/"+race.ID+":setStatus", map[string]interface{}{"status": "RACE_STATUS_CLOSED"})
+
+			resp, err = http.Get(apiHost + "v1/races/" + race.ID + "/revisions")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			var revisions raceRevisionsResponse
+			_ = json.NewDecoder(resp.Body).Decode(&revisions)
+
+			if len(revisions.Revisions) < 3 {
+				t.Fatalf("Unexpected revisions of race %s: %+v", race.ID, revisions.Revisions)
+			}
+
+			scratched := revisions.Revisions[len(revisions.Revisions)-2]
+			if scratched.Kind != "RACE_CHANGE_KIND_UPDATED" || scratched.Actor != "steward" || scratched.Reason != "vet check" {
+				t.Errorf("Unexpected revision of race %s for the scratching: %+v", race.ID, scratched)
+			}
+
+			field := "runners[" + runners.Runners[0].ID + "].scratched"
+			if len(scratched.Changes) != 1 || scratched.Changes[0].Field != field || scratched.Changes[0].OldValue != "false" || scratched.Changes[0].NewValue != "true" {
+				t.Errorf("Unexpected changes of the scratching: %+v (expected %s to change from false to true)", scratched.Changes, field)
+			}
+
+			return
+		}
+
+		t.Fatal("Expected a closed race with runners")
+	})
+}
+
+func TestRaceResult(t *testing.T) {
+	// Race 1 is hidden and closed, an interim result can be recorded for it again on every run.
+	resp, err := http.Get(apiHost + "v1/races/1/runners?exclude_scratched=true")
+	if err != nil {
+		t.Fatalf("Failed to make GET request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var runnersResp listRunnersResponse
+	if err := json.NewDecoder(resp.Body).Decode(&runnersResp); err != nil {
+		t.Fatalf("Failed to decode JSON response: %v", err)
+	}
+
+	if len(runnersResp.Runners) < 3 {
+		t.Fatalf("Unexpected field size: %d (expected at least 3)", len(runnersResp.Runners))
+	}
+	runners := runnersResp.Runners
+
+	tests := []struct {
+		name       string
+		url        string
+		placings   []map[string]interface{}
+		statusCode int
+	}{
+		{
+			name: "Records an interim result with a dead heat for first",
+			url:  apiHost + "v1/races/1/result",
+			placings: []map[string]interface{}{
+				{"runner_id": runners[0].ID, "position": 1},
+				{"runner_id": runners[1].ID, "position": 1},
+				{"runner_id": runners[2].ID, "position": 3, "margin": 1.5},
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Rejects positions that skip a place",
+			url:  apiHost + "v1/races/1/result",
+			placings: []map[string]interface{}{
+				{"runner_id": runners[0].ID, "position": 1},
+				{"runner_id": runners[1].ID, "position": 3, "margin": 0.5},
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Rejects a runner from another race",
+			url:  apiHost + "v1/races/1/result",
+			placings: []map[string]interface{}{
+				{"runner_id": 5701, "position": 1},
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Not found for a non-existent race",
+			url:  apiHost + "v1/races/999/result",
+			placings: []map[string]interface{}{
+				{"runner_id": runners[0].ID, "position": 1},
+			},
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			body, _ := json.Marshal(map[string]interface{}{"placings": tc.placings, "protest_status": "PROTEST_STATUS_LODGED"})
+			resp, err := http.Post(tc.url, "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatalf("Failed to make POST request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tc.statusCode {
+				t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, tc.statusCode)
+			}
+		})
+	}
+
+	t.Run("Gets the recorded result", func(t *testing.T) {
+		resp, err := http.Get(apiHost + "v1/races/1/result")
+		if err != nil {
+			t.Fatalf("Failed to make GET request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var resultResp raceResultResponse
+		if err := json.NewDecoder(resp.Body).Decode(&resultResp); err != nil {
+			t.Fatalf("Failed to decode JSON response: %v", err)
+		}
+
+		placings := resultResp.Result.Placings
+		if len(placings) != 3 || !placings[0].DeadHeat || !placings[1].DeadHeat || placings[2].DeadHeat {
+			t.Errorf("Unexpected placings: %+v", placings)
+		}
+
+		if resultResp.Result.ProtestStatus != "PROTEST_STATUS_LODGED" {
+			t.Errorf("Unexpected protest status: %s (expected %s)", resultResp.Result.ProtestStatus, "PROTEST_STATUS_LODGED")
+		}
+	})
+
+	t.Run("Lists races with their result", func(t *testing.T) {
+		resp, err := makePostRequest(apiHost+"v1/list-races", map[string]interface{}{"filter": map[string]interface{}{}, "include_result": true})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range resp.Races {
+			if v.ID == "1" && (v.Result == nil || v.Status != "RACE_STATUS_INTERIM") {
+				t.Errorf("Unexpected race 1: status %s result %+v", v.Status, v.Result)
+			}
+		}
+	})
+
+	t.Run("Not found without a recorded result", func(t *testing.T) {
+		resp, err := http.Get(apiHost + "v1/races/999/result")
+		if err != nil {
+			t.Fatalf("Failed to make GET request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusNotFound)
+		}
+	})
+}
+
+func TestRacePrices(t *testing.T) {
+	getPrices := func(t *testing.T, url string, statusCode int) []RunnerPrice {
+		resp, err := http.Get(url)
+		if err != nil {
+			t.Fatalf("Failed to make GET request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != statusCode {
+			t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, statusCode)
+		}
+
+		var pricesResp pricesResponse
+		if err := json.NewDecoder(resp.Body).Decode(&pricesResp); err != nil {
+			t.Fatalf("Failed to decode JSON response: %v", err)
+		}
+
+		return pricesResp.Prices
+	}
+
+	t.Run("Gets the current price of every runner", func(t *testing.T) {
+		prices := getPrices(t, apiHost+"v1/races/57/prices", http.StatusOK)
+		if len(prices) != 9 {
+			t.Fatalf("Unexpected prices length: %d (expected %d)", len(prices), 9)
+		}
+
+		for _, v := range prices {
+			if v.Win <= 1 || v.Place <= 1 || v.Place > v.Win {
+				t.Errorf("Unexpected price for runner %s: win %v place %v", v.RunnerID, v.Win, v.Place)
+			}
+		}
+	})
+
+	t.Run("Lists the price history of a runner oldest first", func(t *testing.T) {
+		prices := getPrices(t, apiHost+"v1/races/57/price-history?runner_ids=5701", http.StatusOK)
+		if len(prices) < 3 {
+			t.Fatalf("Unexpected history length: %d (expected at least %d)", len(prices), 3)
+		}
+
+		for k := 0; k+1 < len(prices); k++ {
+			time1, _ := time.Parse(time.RFC3339, prices[k].UpdatedTime)
+			time2, _ := time.Parse(time.RFC3339, prices[k+1].UpdatedTime)
+			if prices[k].RunnerID != "5701" || time1.After(time2) {
+				t.Errorf("Unexpected price history: %+v before %+v", prices[k], prices[k+1])
+			}
+		}
+	})
+
+	t.Run("Not found for a non-existent race", func(t *testing.T) {
+		getPrices(t, apiHost+"v1/races/999/prices", http.StatusNotFound)
+	})
+
+	t.Run("Rejects price updates for a closed race", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"prices": []map[string]interface{}{{"runner_id": 5701, "win": 3.5, "place": 1.6}}})
+		resp, err := http.Post(apiHost+"v1/races/57/prices", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to make POST request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestWatchRaces(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	watch := func(query string) (<-chan watchRacesResponse, <-chan error) {
+		changes := make(chan watchRacesResponse)
+		errs := make(chan error, 1)
+
+		go func() {
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, apiHost+"v1/races:watch?filter.meeting_ids=8"+query, nil)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer resp.Body.Close()
+
+			decoder := json.NewDecoder(resp.Body)
+			for {
+				var change watchRacesResponse
+				if err := decoder.Decode(&change); err != nil {
+					errs <- err
+					return
+				}
+				changes <- change
+			}
+		}()
+
+		return changes, errs
+	}
+
+	next := func(changes <-chan watchRacesResponse, errs <-chan error) watchRacesResponse {
+		select {
+		case change := <-changes:
+			return change
+		case err := <-errs:
+			t.Fatalf("Stream failed: %v", err)
+		case <-ctx.Done():
+			t.Fatal("Timed out waiting for a race change")
+		}
+		return watchRacesResponse{}
+	}
+
+	setStatus := func(status string) {
+		body, _ := json.Marshal(map[string]interface{}{"status": status})
+		resp, err := http.Post(apiHost+"v1/races/57:setStatus", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to make POST request: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	changes, errs := watch("")
+	// The stream only carries changes made after it is opened.
+	time.Sleep(500 * time.Millisecond)
+	setStatus("RACE_STATUS_SUSPENDED")
+	setStatus("RACE_STATUS_CLOSED")
+
+	first := next(changes, errs)
+	second := next(changes, errs)
+	if first.Result.Race.ID != "57" || first.Result.Kind != "RACE_CHANGE_KIND_STATUS_CHANGED" || first.Result.Race.Status == "" {
+		t.Errorf("Unexpected first change: %+v", first.Result)
+	}
+	if second.Result.Race.Status != "RACE_STATUS_CLOSED" {
+		t.Errorf("Unexpected second change status: %s (expected %s)", second.Result.Race.Status, "RACE_STATUS_CLOSED")
+	}
+
+	t.Run("Resumes after a sequence number", func(t *testing.T) {
+		changes, errs := watch("&resume_sequence=" + first.Result.Sequence)
+
+		resumed := next(changes, errs)
+		if resumed.Result.Sequence != second.Result.Sequence {
+			t.Errorf("Unexpected resumed sequence: %s (expected %s)", resumed.Result.Sequence, second.Result.Sequence)
+		}
+	})
+}
+
+func TestListRacesPagination(t *testing.T) {
+	t.Run("Pages through visible races ordered by advertised_start_time", func(t *testing.T) {
+		filter := map[string]interface{}{
+			"visible": true,
+			"sort":    []map[string]interface{}{{"field": "RACE_SORT_FIELD_ADVERTISED_START_TIME"}},
+		}
+
+		seen := make(map[string]bool)
+		pageToken := ""
+		pages := 0
+
+		for {
+			data := map[string]interface{}{"filter": filter, "page_size": 10, "page_token": pageToken}
+			resp, err := makePostRequest(apiHost+"v1/list-races", data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(resp.Races) > 10 {
+				t.Fatalf("Unexpected page length: %d (expected at most %d)", len(resp.Races), 10)
+			}
+
+			for _, v := range resp.Races {
+				if seen[v.ID] {
+					t.Fatalf("Race %s returned on more than one page", v.ID)
+				}
+				seen[v.ID] = true
+			}
+
+			pages++
+			if resp.NextPageToken == "" {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+
+		if len(seen) != 54 || pages != 6 {
+			t.Errorf("Unexpected paginated result: %d races over %d pages (expected 54 races over 6 pages)", len(seen), pages)
+		}
+	})
+
+	t.Run("Carries on after the last race of a page is deleted", func(t *testing.T) {
+		var ids []string
+		for i := 1; i <= 3; i++ {
+			body, _ := json.Marshal(map[string]interface{}{
+				"meeting_id":            8,
+				"name":                  "Anchorage Stakes",
+				"number":                i,
+				"visible":               true,
+				"advertised_start_time": time.Now().Add(72 * time.Hour).UTC().Format(time.RFC3339),
+			})
+			resp, err := http.Post(apiHost+"v1/races", "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var created getRaceResponse
+			_ = json.NewDecoder(resp.Body).Decode(&created)
+			resp.Body.Close()
+			ids = append(ids, created.Race.ID)
+		}
+
+		deleteRace := func(id string) {
+			req, _ := http.NewRequest(http.MethodDelete, apiHost+"v1/races/"+id, nil)
+			if resp, err := http.DefaultClient.Do(req); err == nil {
+				resp.Body.Close()
+			}
+		}
+		defer func() {
+			for _, id := range ids[1:] {
+				deleteRace(id)
+			}
+		}()
+
+		// Relevance moves as matching races are deleted, so the races are ordered by a stored field.
+		filter := map[string]interface{}{
+			"query": "anchorage",
+			"sort":  []map[string]interface{}{{"field": "RACE_SORT_FIELD_NUMBER"}},
+		}
+		first, err := makePostRequest(apiHost+"v1/list-races", map[string]interface{}{"filter": filter, "page_size": 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(first.Races) != 1 || first.NextPageToken == "" {
+			t.Fatalf("Unexpected first page: %+v", first)
+		}
+
+		deleteRace(first.Races[0].ID)
+
+		rest, err := makePostRequest(apiHost+"v1/list-races", map[string]interface{}{"filter": filter, "page_size": 10, "page_token": first.NextPageToken})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(rest.Races) != 2 {
+			t.Errorf("Unexpected races after the deleted anchor: %+v (expected the other 2 of %v)", rest.Races, ids)
+		}
+	})
+
+	t.Run("Pages through races ordered by relevance", func(t *testing.T) {
+		create := func() string {
+			body, _ := json.Marshal(map[string]interface{}{
+				"meeting_id":            8,
+				"name":                  "Bandicoot Stakes",
+				"number":                1,
+				"visible":               true,
+				"advertised_start_time": time.Now().Add(72 * time.Hour).UTC().Format(time.RFC3339),
+			})
+			resp, err := http.Post(apiHost+"v1/races", "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			var created getRaceResponse
+			_ = json.NewDecoder(resp.Body).Decode(&created)
+			return created.Race.ID
+		}
+
+		var ids []string
+		defer func() {
+			for _, id := range ids {
+				req, _ := http.NewRequest(http.MethodDelete, apiHost+"v1/races/"+id, nil)
+				if resp, err := http.DefaultClient.Do(req); err == nil {
+					resp.Body.Close()
+				}
+			}
+		}()
+		for i := 0; i < 3; i++ {
+			ids = append(ids, create())
+		}
+
+		// The races rank equally, so each page resumes on the id that breaks the tie.
+		filter := map[string]interface{}{"query": "bandicoot"}
+		seen := make(map[string]bool)
+		var firstToken string
+		for pageToken := ""; ; {
+			resp, err := makePostRequest(apiHost+"v1/list-races", map[string]interface{}{"filter": filter, "page_size": 1, "page_token": pageToken})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, v := range resp.Races {
+				if seen[v.ID] {
+					t.Fatalf("Race %s returned on more than one page", v.ID)
+				}
+				seen[v.ID] = true
+			}
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			if firstToken == "" {
+				firstToken = resp.NextPageToken
+			}
+			pageToken = resp.NextPageToken
+		}
+
+		if len(seen) != len(ids) {
+			t.Errorf("Unexpected races over the pages: %v (expected %v)", seen, ids)
+		}
+
+		// Writing a match shifts every race's relevance, which leaves the races of later pages unspecified,
+		// but a token issued before the write is still honoured.
+		ids = append(ids, create())
+		if _, err := makePostRequest(apiHost+"v1/list-races", map[string]interface{}{"filter": filter, "page_size": 1, "page_token": firstToken}); err != nil {
+			t.Errorf("Unexpected error resuming after a match was written: %v", err)
+		}
+	})
+
+	t.Run("Rejects a page token issued for another filter", func(t *testing.T) {
+		resp, err := makePostRequest(apiHost+"v1/list-races", map[string]interface{}{"filter": map[string]interface{}{}, "page_size": 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := json.Marshal(map[string]interface{}{
+			"filter":     map[string]interface{}{"visible": true},
+			"page_size":  10,
+			"page_token": resp.NextPageToken,
+		})
+		httpResp, err := http.Post(apiHost+"v1/list-races", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer httpResp.Body.Close()
+
+		if httpResp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status code: %d (expected %d)", httpResp.StatusCode, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestSetRaceStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		status     string
+		statusCode int
+	}{
+		{
+			name:       "Suspends a closed race",
+			url:        apiHost + "v1/races/57:setStatus",
+			status:     "RACE_STATUS_SUSPENDED",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "Rejects an illegal transition from suspended to final",
+			url:        apiHost + "v1/races/57:setStatus",
+			status:     "RACE_STATUS_FINAL",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "Closes the suspended race again",
+			url:        apiHost + "v1/races/57:setStatus",
+			status:     "RACE_STATUS_CLOSED",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "Rejects an unspecified status",
+			url:        apiHost + "v1/races/57:setStatus",
+			status:     "RACE_STATUS_UNSPECIFIED",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "Not found for a non-existent race",
+			url:        apiHost + "v1/races/999:setStatus",
+			status:     "RACE_STATUS_SUSPENDED",
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			body, _ := json.Marshal(map[string]interface{}{"status": tc.status})
+			resp, err := http.Post(tc.url, "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatalf("Failed to make POST request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tc.statusCode {
+				t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, tc.statusCode)
+			}
+
+			if tc.statusCode != http.StatusOK {
+				return
+			}
+
+			var raceResp getRaceResponse
+			if err := json.NewDecoder(resp.Body).Decode(&raceResp); err != nil {
+				t.Fatalf("Failed to decode JSON response: %v", err)
+			}
+
+			if raceResp.Race.Status != tc.status {
+				t.Errorf("Unexpected race status: %v (expected %v)", raceResp.Race.Status, tc.status)
+			}
+
+			if raceResp.Race.LocalAdvertisedStartTime == "" {
+				t.Errorf("Expected the local advertised start time of race %s", raceResp.Race.ID)
+			}
+		})
+	}
+}
+
+func TestRacesCalendar(t *testing.T) {
+	advertisedStart := time.Now().Add(72 * time.Hour).UTC().Truncate(time.Second)
+	body, _ := json.Marshal(map[string]interface{}{
+		"meeting_id":            8,
+		"name":                  "Calendar Cup, Open; Handicap",
+		"number":                11,
+		"visible":               true,
+		"advertised_start_time": advertisedStart.Format(time.RFC3339),
+	})
+	resp, err := http.Post(apiHost+"v1/races", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var created getRaceResponse
+	_ = json.NewDecoder(resp.Body).Decode(&created)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected create status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+	}
+
+	defer func() {
+		req, _ := http.NewRequest(http.MethodDelete, apiHost+"v1/races/"+created.Race.ID, nil)
+		if resp, err := http.DefaultClient.Do(req); err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	getCalendar := func(t *testing.T, query string) string {
+		resp, err := http.Get(apiHost + "v1/calendar/races.ics?" + query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+		}
+
+		if contentType := resp.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "text/calendar") {
+			t.Errorf("Unexpected content type %q", contentType)
+		}
+
+		var b bytes.Buffer
+		_, _ = b.ReadFrom(resp.Body)
+
+		return b.String()
+	}
+
+	uid := "UID:race-" + created.Race.ID + "@racing.entain\r\n"
+
+	t.Run("Renders upcoming races of the meeting", func(t *testing.T) {
+		calendar := getCalendar(t, "meeting_ids=8")
+
+		if !strings.HasPrefix(calendar, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(calendar, "END:VCALENDAR\r\n") {
+			t.Fatalf("Unexpected calendar %q", calendar)
+		}
+
+		if !strings.Contains(calendar, uid) {
+			t.Errorf("Expected the created race in %q", calendar)
+		}
+
+		if !strings.Contains(calendar, "DTSTART:"+advertisedStart.Format("20060102T150405Z")+"\r\n") {
+			t.Errorf("Expected the advertised start time of the created race in %q", calendar)
+		}
+
+		if !strings.Contains(calendar, `Calendar Cup\, Open\; Handicap`) {
+			t.Errorf("Expected the escaped race name in %q", calendar)
+		}
+
+		now := time.Now().UTC().Format("20060102T150405Z")
+		for _, line := range strings.Split(calendar, "\r\n") {
+			if len(line) > 75 {
+				t.Errorf("Unexpected unfolded line %q", line)
+			}
+
+			// The timestamps sort as strings, as they share a fixed width UTC format.
+			if strings.HasPrefix(line, "DTSTART:") && line[len("DTSTART:"):] < now {
+				t.Errorf("Unexpected race starting in the past: %q", line)
+			}
+		}
+	})
+
+	t.Run("Leaves out hidden races unless asked for", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"meeting_id":            8,
+			"name":                  "Calendar Trial",
+			"number":                12,
+			"visible":               false,
+			"advertised_start_time": advertisedStart.Format(time.RFC3339),
+		})
+		resp, err := http.Post(apiHost+"v1/races", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var hidden getRaceResponse
+		_ = json.NewDecoder(resp.Body).Decode(&hidden)
+		resp.Body.Close()
+
+		defer func() {
+			req, _ := http.NewRequest(http.MethodDelete, apiHost+"v1/races/"+hidden.Race.ID, nil)
+			if resp, err := http.DefaultClient.Do(req); err == nil {
+				resp.Body.Close()
+			}
+		}()
+
+		hiddenUID := "UID:race-" + hidden.Race.ID + "@racing.entain\r\n"
+		tests := map[string]bool{
+			"meeting_ids=8":                              false,
+			"meeting_ids=8&visible=true":                 false,
+			"meeting_ids=8&visibility=VISIBILITY_HIDDEN": true,
+			"meeting_ids=8&visibility=any":               true,
+		}
+
+		for query, expected := range tests {
+			if calendar := getCalendar(t, query); strings.Contains(calendar, hiddenUID) != expected {
+				t.Errorf("Unexpected hidden race in the calendar for %q: %v (expected %v)", query, !expected, expected)
+			}
+		}
+	})
+
+	t.Run("Leaves out other meetings", func(t *testing.T) {
+		if calendar := getCalendar(t, "meeting_ids=1,2"); strings.Contains(calendar, uid) {
+			t.Errorf("Unexpected race of meeting 8 in %q", calendar)
+		}
+	})
+
+	t.Run("Rejects invalid filters", func(t *testing.T) {
+		for _, query := range []string{"meeting_ids=eight", "visible=maybe", "visibility=sometimes"} {
+			resp, err := http.Get(apiHost + "v1/calendar/races.ics?" + query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("Unexpected status code for %q: %d (expected %d)", query, resp.StatusCode, http.StatusBadRequest)
+			}
+		}
+	})
+}
+
+func TestListRacesVisibility(t *testing.T) {
+	count := func(t *testing.T, filter map[string]interface{}, wantVisible ...bool) int {
+		resp, err := makePostRequest(apiHost+"v1/list-races", map[string]interface{}{"filter": filter})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, race := range resp.Races {
+			if len(wantVisible) > 0 && race.Visible != wantVisible[0] {
+				t.Errorf("Unexpected race %s with visible %v for filter %v", race.ID, race.Visible, filter)
+			}
+		}
+
+		return len(resp.Races)
+	}
+
+	all := count(t, map[string]interface{}{})
+	visible := count(t, map[string]interface{}{"visibility": "VISIBILITY_VISIBLE"}, true)
+	hidden := count(t, map[string]interface{}{"visibility": "VISIBILITY_HIDDEN"}, false)
+
+	if visible == 0 || hidden == 0 || visible+hidden != all {
+		t.Errorf("Unexpected counts: %d visible and %d hidden of %d races", visible, hidden, all)
+	}
+
+	if n := count(t, map[string]interface{}{"visible": true}); n != visible {
+		t.Errorf("Unexpected number of races for the visible flag: %d (expected %d)", n, visible)
+	}
+
+	if n := count(t, map[string]interface{}{"visible": true, "visibility": "VISIBILITY_HIDDEN"}, false); n != hidden {
+		t.Errorf("Unexpected number of races when visibility wins over the visible flag: %d (expected %d)", n, hidden)
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{"filter": map[string]interface{}{"visibility": 7}})
+	resp, err := http.Post(apiHost+"v1/list-races", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Unexpected status code for an unknown visibility: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestListVenues(t *testing.T) {
+	type venue struct {
+		ID       string `json:"id"`
+		Name     string `json:"name"`
+		Country  string `json:"country"`
+		Timezone string `json:"timezone"`
+	}
+
+	listVenues := func(t *testing.T, filter map[string]interface{}) []venue {
+		body, _ := json.Marshal(map[string]interface{}{"filter": filter})
+		resp, err := http.Post(apiHost+"v1/list-racing-venues", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		var listResp struct {
+			Venues []venue `json:"venues"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+			t.Fatalf("Failed to decode JSON response: %v", err)
+		}
+
+		return listResp.Venues
+	}
+
+	all := listVenues(t, map[string]interface{}{})
+	if len(all) == 0 {
+		t.Fatal("Expected venues")
+	}
+
+	for _, v := range all {
+		if _, err := time.LoadLocation(v.Timezone); err != nil || v.Name == "" {
+			t.Errorf("Unexpected venue %+v", v)
+		}
+	}
+
+	for _, v := range listVenues(t, map[string]interface{}{"country": "nzl"}) {
+		if v.Country != "NZL" {
+			t.Errorf("Unexpected venue %s in %s", v.Name, v.Country)
+		}
+	}
+
+	t.Run("Filters meetings by venue", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"filter": map[string]interface{}{"venue_ids": []string{all[0].ID}}})
+		resp, err := http.Post(apiHost+"v1/list-meetings", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		var listResp listMeetingsResponse
+		if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+			t.Fatalf("Failed to decode JSON response: %v", err)
+		}
+
+		if len(listResp.Meetings) == 0 {
+			t.Fatalf("Expected meetings at venue %s", all[0].ID)
+		}
+
+		for _, m := range listResp.Meetings {
+			if m.VenueID != all[0].ID || m.Venue != all[0].Name {
+				t.Errorf("Unexpected meeting %s at %s (venue %s)", m.ID, m.Venue, m.VenueID)
+			}
+		}
+	})
+
+	t.Run("Races start at the same instant in local time", func(t *testing.T) {
+		resp, err := makePostRequest(apiHost+"v1/list-races", map[string]interface{}{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, race := range resp.Races {
+			advertised, err := time.Parse(time.RFC3339, race.AdvertisedStartTime)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			local, err := time.Parse(time.RFC3339, race.LocalAdvertisedStartTime)
+			if err != nil {
+				t.Fatalf("Unexpected local start time %q of race %s: %v", race.LocalAdvertisedStartTime, race.ID, err)
+			}
+
+			if !local.Equal(advertised) {
+				t.Errorf("Unexpected local start time %s of race %s (expected %s)", local, race.ID, advertised)
+			}
+		}
+	})
+}
+
 func makePostRequest(url string, requestBody interface{}) (*listRacesResponse, error) {
 	// Marshal the request body to JSON bytes
 	requestBodyJSON, err := json.Marshal(requestBody)
@@ -392,7 +2362,7 @@ func compareRaces(t *testing.T, actual *racing.Race, expected *racing.Race) bool
 	}
 
 	if actual.Status != expected.Status {
-		t.Errorf("Race Status mismatch: Actual: %s, Expected: %s", actual.Status, expected.Status)
+		t.Errorf("Race Status mismatch: Actual: %v, Expected: %v", actual.Status, expected.Status)
 		return false
 	}
 
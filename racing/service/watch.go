@@ -0,0 +1,170 @@
+package service
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/db"
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+const (
+	// watchPollInterval bounds how long a stream waits before checking the change log without being notified,
+	// which picks up changes written by other processes and races closed by CloseStartedRaces.
+	watchPollInterval = 2 * time.Second
+
+	// watchBatchSize is the number of changes read from the change log at a time.
+	watchBatchSize = 100
+)
+
+// raceFeed wakes WatchRaces streams when races change.
+type raceFeed struct {
+	mu      sync.Mutex
+	changed chan struct{}
+}
+
+// newRaceFeed instantiates and returns a new raceFeed.
+func newRaceFeed() *raceFeed {
+	return &raceFeed{changed: make(chan struct{})}
+}
+
+// wait returns a channel closed on the next notify, it must be taken before reading the change log.
+func (f *raceFeed) wait() <-chan struct{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.changed
+}
+
+// notify wakes every waiting stream.
+func (f *raceFeed) notify() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+func (s *racingService) WatchRaces(in *racing.WatchRacesRequest, stream racing.Racing_WatchRacesServer) error {
+	if err := validateTimeRange("filter.advertised_start", in.GetFilter().GetAdvertisedStartFrom(), in.GetFilter().GetAdvertisedStartTo()); err != nil {
+		return err
+	}
+
+	if err := validateVisibility(in.GetFilter().GetVisibility()); err != nil {
+		return err
+	}
+
+	// Queries are matched by the search index, which a single changed race can't be checked against.
+	if in.GetFilter().GetQuery() != "" {
+		return invalidArgument("filter.query", "query is not supported when watching races")
+	}
+
+	after := in.ResumeSequence
+	if after <= 0 {
+		latest, err := s.changesRepo.Latest()
+		if err != nil {
+			return err
+		}
+		after = latest
+	}
+
+	for {
+		wait := s.feed.wait()
+
+		changes, err := s.changesRepo.List(after, watchBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			if err := s.sendRaceChange(stream, in.Filter, change); err != nil {
+				return err
+			}
+			after = change.Sequence
+		}
+
+		if len(changes) == watchBatchSize {
+			continue
+		}
+
+		select {
+		case <-wait:
+		case <-time.After(watchPollInterval):
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// sendRaceChange streams a change when its race matches the filter.
+// Deleted races can no longer be matched, so their deletion is streamed to every watcher.
+func (s *racingService) sendRaceChange(stream racing.Racing_WatchRacesServer, filter *racing.ListRacesRequestFilter, change *db.RaceChange) error {
+	if change.Kind == racing.RaceChangeKind_RACE_CHANGE_KIND_DELETED {
+		return stream.Send(&racing.WatchRacesResponse{
+			Sequence:   change.Sequence,
+			Kind:       change.Kind,
+			Race:       &racing.Race{Id: change.RaceID},
+			ChangeTime: timestamppb.New(change.Time),
+		})
+	}
+
+	race, err := s.racesRepo.GetRace(&racing.GetRaceRequest{Id: change.RaceID})
+	if errors.Is(err, db.ErrRaceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !raceMatches(filter, race) {
+		return nil
+	}
+
+	if err := s.embedLocalTimes([]*racing.Race{race}); err != nil {
+		return err
+	}
+
+	return stream.Send(&racing.WatchRacesResponse{
+		Sequence:   change.Sequence,
+		Kind:       change.Kind,
+		Race:       race,
+		ChangeTime: timestamppb.New(change.Time),
+	})
+}
+
+// raceMatches reports whether a race is selected by the visibility, advertised start and meeting parts of a filter.
+func raceMatches(filter *racing.ListRacesRequestFilter, race *racing.Race) bool {
+	switch db.FilterVisibility(filter) {
+	case racing.Visibility_VISIBILITY_VISIBLE:
+		if !race.Visible {
+			return false
+		}
+	case racing.Visibility_VISIBILITY_HIDDEN:
+		if race.Visible {
+			return false
+		}
+	}
+
+	advertisedStart := race.AdvertisedStartTime.AsTime()
+	if from := filter.GetAdvertisedStartFrom(); from != nil && advertisedStart.Before(from.AsTime()) {
+		return false
+	}
+	if to := filter.GetAdvertisedStartTo(); to != nil && !advertisedStart.Before(to.AsTime()) {
+		return false
+	}
+
+	if len(filter.GetMeetingIds()) == 0 {
+		return true
+	}
+
+	for _, meetingID := range filter.GetMeetingIds() {
+		if meetingID == race.MeetingId {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"git.neds.sh/matty/entain/racing/proto/racing"
+)
+
+func TestRaceMatches(t *testing.T) {
+	advertisedStart := time.Date(2026, time.October, 18, 5, 15, 0, 0, time.UTC)
+	race := &racing.Race{Id: 1, MeetingId: 8, Visible: true, AdvertisedStartTime: timestamppb.New(advertisedStart)}
+
+	tests := map[string]struct {
+		filter   *racing.ListRacesRequestFilter
+		expected bool
+	}{
+		"No filter":                  {nil, true},
+		"Meeting":                    {&racing.ListRacesRequestFilter{MeetingIds: []int64{8}}, true},
+		"Other meeting":              {&racing.ListRacesRequestFilter{MeetingIds: []int64{9}}, false},
+		"Hidden":                     {&racing.ListRacesRequestFilter{Visibility: racing.Visibility_VISIBILITY_HIDDEN}, false},
+		"Window holding the start":   {&racing.ListRacesRequestFilter{AdvertisedStartFrom: timestamppb.New(advertisedStart), AdvertisedStartTo: timestamppb.New(advertisedStart.Add(time.Minute))}, true},
+		"Window ending at the start": {&racing.ListRacesRequestFilter{AdvertisedStartTo: timestamppb.New(advertisedStart)}, false},
+		"Window after the start":     {&racing.ListRacesRequestFilter{AdvertisedStartFrom: timestamppb.New(advertisedStart.Add(time.Second))}, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if matched := raceMatches(test.filter, race); matched != test.expected {
+				t.Errorf("Unexpected match %t (expected %t)", matched, test.expected)
+			}
+		})
+	}
+}
+
+func TestWatchRacesRejectsQuery(t *testing.T) {
+	s := &racingService{}
+
+	// The filter is validated before the stream is used.
+	err := s.WatchRaces(&racing.WatchRacesRequest{Filter: &racing.ListRacesRequestFilter{Query: "randwick"}}, nil)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Unexpected error watching races by query: %v", err)
+	}
+
+	details := status.Convert(err).Details()
+	if len(details) != 1 {
+		t.Fatalf("Unexpected error details: %v", details)
+	}
+
+	if violations := details[0].(*errdetails.BadRequest).FieldViolations; len(violations) != 1 || violations[0].Field != "filter.query" {
+		t.Errorf("Unexpected field violations: %v (expected %s)", violations, "filter.query")
+	}
+}
@@ -0,0 +1,7 @@
+package db
+
+import "time"
+
+// Clock returns the current time. Repositories read the time through a Clock rather than the wall clock,
+// so fields derived from it, such as event status, can be evaluated at a fixed instant.
+type Clock func() time.Time
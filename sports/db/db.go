@@ -1,33 +1,364 @@
 package db
 
 import (
+	"database/sql"
+	"fmt"
+	"math/rand"
 	"time"
 
 	"syreclabs.com/go/faker"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
 )
 
 func (s *sportsRepo) seed() error {
-	statement, err := s.db.Prepare(`CREATE TABLE IF NOT EXISTS sports (id INTEGER PRIMARY KEY, name TEXT, result TEXT, location TEXT, visible INTEGER, start_time DATETIME, end_time DATETIME, advertised_start_time DATETIME)`)
+	statement, err := s.db.Prepare(`CREATE TABLE IF NOT EXISTS sports (id INTEGER PRIMARY KEY, name TEXT, result TEXT, location TEXT, visible INTEGER, start_time DATETIME, end_time DATETIME, advertised_start_time DATETIME, sport_id INTEGER NOT NULL DEFAULT 0, competition_id INTEGER NOT NULL DEFAULT 0, status_override INTEGER NOT NULL DEFAULT 0, venue_id INTEGER NOT NULL DEFAULT 0)`)
 	if err == nil {
 		_, err = statement.Exec()
 	}
 
+	for _, column := range []string{"sport_id", "competition_id", "status_override", "venue_id"} {
+		if err == nil {
+			_, err = addColumn(s.db, "sports", column, "INTEGER NOT NULL DEFAULT 0")
+		}
+	}
+
 	for i := 1; i <= 100; i++ {
-		statement, err = s.db.Prepare(`INSERT OR IGNORE INTO sports(id, name, result, location, visible, start_time, end_time, advertised_start_time) VALUES (?,?,?,?,?,?,?,?)`)
+		statement, err = s.db.Prepare(`INSERT OR IGNORE INTO sports(id, name, result, visible, start_time, end_time, advertised_start_time) VALUES (?,?,?,?,?,?,?)`)
 		if err == nil {
 			_, err = statement.Exec(
 				i,
 				faker.Team().Name(),
 				faker.Team().State(),
-				faker.Address().City(),
 				faker.Number().Between(0, 1),
 				// make sure the start time gather than the end time
-				faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 0)).Format(time.RFC3339),
-				faker.Time().Between(time.Now().AddDate(0, 0, 1), time.Now().AddDate(0, 0, 2)).Format(time.RFC3339),
-				faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).Format(time.RFC3339),
+				faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 0)).UTC(),
+				faker.Time().Between(time.Now().AddDate(0, 0, 1), time.Now().AddDate(0, 0, 2)).UTC(),
+				faker.Time().Between(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2)).UTC(),
 			)
 		}
 	}
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Events seeded by older versions were stored with a local offset.
+	if err := normaliseTimes(s.db, "sports", "start_time", "end_time", "advertised_start_time"); err != nil {
+		return err
+	}
+
+	// Events without a competition, including those seeded before competitions existed, are spread across the seeded ones.
+	if _, err := s.db.Exec(`UPDATE sports SET competition_id = (id - 1) % ? + 1 WHERE competition_id = 0`, len(seedCompetitions)); err != nil {
+		return err
+	}
+
+	if _, err := s.db.Exec(`UPDATE sports SET sport_id = (SELECT sport_id FROM competitions WHERE competitions.id = sports.competition_id) WHERE sport_id = 0`); err != nil {
+		return err
+	}
+
+	// Events without a venue, including those seeded before venues existed, take turns at the venues of their competition.
+	for i, c := range seedCompetitions {
+		for k, venueID := range c.venueIDs {
+			_, err := s.db.Exec(
+				`UPDATE sports SET venue_id = ? WHERE venue_id = 0 AND competition_id = ? AND id % ? = ?`,
+				venueID, i+1, len(c.venueIDs), k,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Events are located at their venue, including those seeded by older versions with a made up city.
+	_, err = s.db.Exec(`UPDATE sports SET location = (SELECT name || ', ' || city FROM venues WHERE venues.id = sports.venue_id)
+		WHERE venue_id IN (SELECT id FROM venues) AND location IS NOT (SELECT name || ', ' || city FROM venues WHERE venues.id = sports.venue_id)`)
+	if err != nil {
+		return err
+	}
+
+	return s.seedSearch()
+}
+
+// seedSports are the sports seeded, sport n is seedSports[n-1].
+// Events of a sport are seeded with competitors taking part, either home and away teams or individuals,
+// scoring between minPoints and maxPoints in each of periods periods.
+var seedSports = []struct {
+	name                 string
+	periodType           sports.PeriodType
+	scoring              sports.Scoring
+	teams                bool
+	competitors          int
+	periods              int
+	minPoints, maxPoints int
+}{
+	{"Rugby League", sports.PeriodType_PERIOD_TYPE_HALF, sports.Scoring_SCORING_HIGHEST_TOTAL, true, 2, 2, 0, 24},
+	{"Australian Rules", sports.PeriodType_PERIOD_TYPE_QUARTER, sports.Scoring_SCORING_HIGHEST_TOTAL, true, 2, 4, 6, 40},
+	{"Tennis", sports.PeriodType_PERIOD_TYPE_SET, sports.Scoring_SCORING_MOST_PERIODS, false, 2, 3, 0, 7},
+	{"Basketball", sports.PeriodType_PERIOD_TYPE_QUARTER, sports.Scoring_SCORING_HIGHEST_TOTAL, true, 2, 4, 15, 35},
+	{"Soccer", sports.PeriodType_PERIOD_TYPE_HALF, sports.Scoring_SCORING_HIGHEST_TOTAL, true, 2, 2, 0, 3},
+	{"Golf", sports.PeriodType_PERIOD_TYPE_ROUND, sports.Scoring_SCORING_LOWEST_TOTAL, false, 8, 4, 64, 78},
+}
+
+// seedCompetitions are the competitions seeded, competition n is seedCompetitions[n-1].
+// Seasons run from the first day of startMonth to the last day of endMonth.
+var seedCompetitions = []struct {
+	sportID    int
+	name       string
+	country    string
+	startMonth time.Month
+	endMonth   time.Month
+	venueIDs   []int64
+}{
+	{1, "NRL", "AUS", time.March, time.October, []int64{1, 2, 11}},
+	{1, "State of Origin", "AUS", time.May, time.July, []int64{1, 2, 3}},
+	{2, "AFL", "AUS", time.March, time.September, []int64{3, 4, 5}},
+	{2, "AFLW", "AUS", time.August, time.November, []int64{3, 4}},
+	{3, "Australian Open", "AUS", time.January, time.January, []int64{6}},
+	{3, "Wimbledon", "GBR", time.July, time.July, []int64{7}},
+	{4, "NBA", "USA", time.October, time.June, []int64{8, 9}},
+	{4, "NBL", "AUS", time.September, time.March, []int64{10}},
+	{5, "A-League Men", "AUS", time.October, time.May, []int64{11, 3}},
+	{5, "UEFA Champions League", "", time.September, time.June, []int64{12}},
+	{6, "The Masters", "USA", time.April, time.April, []int64{13}},
+	{6, "The Open", "GBR", time.July, time.July, []int64{14}},
+}
+
+// seedVenues are the venues seeded, venue n is seedVenues[n-1].
+var seedVenues = []struct {
+	name, city, country string
+	latitude, longitude float64
+	timezone            string
+}{
+	{"Accor Stadium", "Sydney", "AUS", -33.8474, 151.0634, "Australia/Sydney"},
+	{"Suncorp Stadium", "Brisbane", "AUS", -27.4648, 153.0095, "Australia/Brisbane"},
+	{"Melbourne Cricket Ground", "Melbourne", "AUS", -37.8200, 144.9834, "Australia/Melbourne"},
+	{"Adelaide Oval", "Adelaide", "AUS", -34.9156, 138.5961, "Australia/Adelaide"},
+	{"Optus Stadium", "Perth", "AUS", -31.9512, 115.8890, "Australia/Perth"},
+	{"Rod Laver Arena", "Melbourne", "AUS", -37.8216, 144.9785, "Australia/Melbourne"},
+	{"All England Lawn Tennis Club", "London", "GBR", 51.4340, -0.2145, "Europe/London"},
+	{"Madison Square Garden", "New York", "USA", 40.7505, -73.9934, "America/New_York"},
+	{"Crypto.com Arena", "Los Angeles", "USA", 34.0430, -118.2673, "America/Los_Angeles"},
+	{"John Cain Arena", "Melbourne", "AUS", -37.8218, 144.9810, "Australia/Melbourne"},
+	{"Allianz Stadium", "Sydney", "AUS", -33.8891, 151.2247, "Australia/Sydney"},
+	{"Santiago Bernabeu", "Madrid", "ESP", 40.4531, -3.6883, "Europe/Madrid"},
+	{"Augusta National Golf Club", "Augusta", "USA", 33.5030, -82.0199, "America/New_York"},
+	{"Royal Troon Golf Club", "Troon", "GBR", 55.5323, -4.6453, "Europe/London"},
+}
+
+func (t *taxonomyRepo) seed() error {
+	tables := []string{
+		`CREATE TABLE IF NOT EXISTS sport_types (id INTEGER PRIMARY KEY, name TEXT, period_type INTEGER NOT NULL DEFAULT 0, scoring INTEGER NOT NULL DEFAULT 0)`,
+		`CREATE TABLE IF NOT EXISTS competitions (id INTEGER PRIMARY KEY, sport_id INTEGER, name TEXT, country TEXT)`,
+		`CREATE TABLE IF NOT EXISTS seasons (id INTEGER PRIMARY KEY, competition_id INTEGER, name TEXT, start_date TEXT, end_date TEXT)`,
+	}
+
+	for _, table := range tables {
+		if _, err := t.db.Exec(table); err != nil {
+			return err
+		}
+	}
+
+	for _, column := range []string{"period_type", "scoring"} {
+		if _, err := addColumn(t.db, "sport_types", column, "INTEGER NOT NULL DEFAULT 0"); err != nil {
+			return err
+		}
+	}
+
+	for i, sport := range seedSports {
+		if _, err := t.db.Exec(`INSERT OR IGNORE INTO sport_types(id, name) VALUES (?,?)`, i+1, sport.name); err != nil {
+			return err
+		}
+
+		// Sports seeded before scores existed are given how they are scored.
+		_, err := t.db.Exec(`UPDATE sport_types SET period_type = ?, scoring = ? WHERE id = ? AND period_type = 0`, sport.periodType, sport.scoring, i+1)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Every competition gets last year's season and this year's, a season ending in an earlier month than it starts runs into the next year.
+	year := time.Now().Year()
+	for i, c := range seedCompetitions {
+		if _, err := t.db.Exec(`INSERT OR IGNORE INTO competitions(id, sport_id, name, country) VALUES (?,?,?,?)`, i+1, c.sportID, c.name, c.country); err != nil {
+			return err
+		}
+
+		for k, seasonYear := range []int{year - 1, year} {
+			start := time.Date(seasonYear, c.startMonth, 1, 0, 0, 0, 0, time.UTC)
+			end := time.Date(seasonYear, c.endMonth+1, 0, 0, 0, 0, 0, time.UTC)
+			name := fmt.Sprint(seasonYear)
+			if c.endMonth < c.startMonth {
+				end = end.AddDate(1, 0, 0)
+				name = fmt.Sprintf("%d/%02d", seasonYear, (seasonYear+1)%100)
+			}
+
+			_, err := t.db.Exec(
+				`INSERT OR IGNORE INTO seasons(id, competition_id, name, start_date, end_date) VALUES (?,?,?,?,?)`,
+				2*i+k+1, i+1, name, start.Format("2006-01-02"), end.Format("2006-01-02"),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *scoresRepo) seed() error {
+	tables := []string{
+		`CREATE TABLE IF NOT EXISTS participants (id INTEGER PRIMARY KEY, event_id INTEGER, name TEXT, side INTEGER)`,
+		`CREATE TABLE IF NOT EXISTS scores (event_id INTEGER, participant_id INTEGER, period INTEGER, points INTEGER, PRIMARY KEY (event_id, participant_id, period))`,
+	}
+
+	for _, table := range tables {
+		if _, err := r.db.Exec(table); err != nil {
+			return err
+		}
+	}
+
+	rows, err := r.db.Query(`SELECT id, sport_id, start_time, end_time FROM sports WHERE id NOT IN (SELECT event_id FROM participants)`)
+	if err != nil {
+		return err
+	}
+
+	type unseeded struct {
+		id, sportID int64
+		start, end  time.Time
+	}
+
+	var events []unseeded
+	for rows.Next() {
+		var event unseeded
+		if err := rows.Scan(&event.id, &event.sportID, &event.start, &event.end); err != nil {
+			rows.Close()
+			return err
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := r.clock()
+	for _, event := range events {
+		if event.sportID < 1 || int(event.sportID) > len(seedSports) {
+			continue
+		}
+
+		if err := seedEventScore(tx, event.id, int(event.sportID-1), event.start, event.end, now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// seedEventScore seeds the participants of an event of seedSports[sport], along with the periods played by now.
+func seedEventScore(tx *sql.Tx, eventID int64, sport int, start, end, now time.Time) error {
+	spec := seedSports[sport]
+
+	participantIDs := make([]int64, spec.competitors)
+	for i := range participantIDs {
+		name, side := faker.Name().Name(), sports.ParticipantSide_PARTICIPANT_SIDE_COMPETITOR
+		if spec.teams {
+			name, side = faker.Team().Name(), sports.ParticipantSide_PARTICIPANT_SIDE_HOME+sports.ParticipantSide(i)
+		}
+
+		result, err := tx.Exec(`INSERT INTO participants(event_id, name, side) VALUES (?,?,?)`, eventID, name, side)
+		if err != nil {
+			return err
+		}
+
+		if participantIDs[i], err = result.LastInsertId(); err != nil {
+			return err
+		}
+	}
+
+	var periods [][]int
+	if spec.scoring == sports.Scoring_SCORING_MOST_PERIODS {
+		periods = seedSets(spec.periods)
+	} else {
+		for period := 0; period < spec.periods; period++ {
+			points := make([]int, spec.competitors)
+			for i := range points {
+				points[i] = spec.minPoints + rand.Intn(spec.maxPoints-spec.minPoints+1)
+			}
+
+			periods = append(periods, points)
+		}
+	}
+
+	// Only the share of periods matching the share of the event that has passed are played.
+	played := len(periods)
+	if now.Before(end) {
+		elapsed := now.Sub(start).Seconds() / end.Sub(start).Seconds()
+		played = int(elapsed * float64(len(periods)))
+		if played < 0 {
+			played = 0
+		}
+	}
+
+	for period, points := range periods[:played] {
+		for i, participantID := range participantIDs {
+			_, err := tx.Exec(`INSERT INTO scores(event_id, participant_id, period, points) VALUES (?,?,?,?)`, eventID, participantID, period+1, points[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// seedSets plays a tennis match of at most bestOf sets, returning the games each of the two players won in each set.
+func seedSets(bestOf int) [][]int {
+	var (
+		sets [][]int
+		won  [2]int
+	)
+
+	for won[0] <= bestOf/2 && won[1] <= bestOf/2 {
+		winner := rand.Intn(2)
+		loserGames := rand.Intn(7)
+
+		winnerGames := 6
+		if loserGames >= 5 {
+			winnerGames = 7
+		}
+
+		games := make([]int, 2)
+		games[winner], games[1-winner] = winnerGames, loserGames
+		sets = append(sets, games)
+		won[winner]++
+	}
+
+	return sets
+}
+
+func (v *venuesRepo) seed() error {
+	if _, err := v.db.Exec(`CREATE TABLE IF NOT EXISTS venues (id INTEGER PRIMARY KEY, name TEXT, city TEXT, country TEXT, latitude REAL, longitude REAL, timezone TEXT)`); err != nil {
+		return err
+	}
+
+	for i, venue := range seedVenues {
+		_, err := v.db.Exec(
+			`INSERT OR IGNORE INTO venues(id, name, city, country, latitude, longitude, timezone) VALUES (?,?,?,?,?,?,?)`,
+			i+1, venue.name, venue.city, venue.country, venue.latitude, venue.longitude, venue.timezone,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
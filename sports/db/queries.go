@@ -15,7 +15,12 @@ func getEventQueries() map[string]string {
 				visible, 
 				start_time, 
 				end_time,
-				advertised_start_time
+				advertised_start_time,
+				sport_id,
+				competition_id,
+				status_override,
+				venue_id,
+				IFNULL((SELECT timezone FROM venues WHERE venues.id = sports.venue_id), '')
 			FROM sports
 		`,
 	}
@@ -0,0 +1,254 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// ScoresRepo provides repository access to the participants of sports events and their scores.
+type ScoresRepo interface {
+	// Init will initialise our scores repository.
+	Init() error
+
+	// Participants will return the participants of the given events keyed by event id, home before away.
+	Participants(eventIDs []int64) (map[int64][]*sports.Participant, error)
+
+	// Scores will return the scores of the given events keyed by event id, deciding the winner of finished events.
+	// A score is final once its event is finished, events cancelled or postponed have no winner.
+	Scores(eventIDs []int64) (map[int64]*sports.Score, error)
+}
+
+type scoresRepo struct {
+	db    *sql.DB
+	clock Clock
+	init  sync.Once
+}
+
+// NewScoresRepo creates a new scores repository, reading the current time from clock.
+func NewScoresRepo(db *sql.DB, clock Clock) ScoresRepo {
+	return &scoresRepo{db: db, clock: clock}
+}
+
+// Init prepares the scores repository dummy data.
+// The events table is read to seed their participants, so the sports repository must be initialised first.
+func (r *scoresRepo) Init() error {
+	var err error
+
+	r.init.Do(func() {
+		// For test/example purposes, we seed the DB with the participants of every event and the periods they have played.
+		err = r.seed()
+	})
+
+	return err
+}
+
+func (r *scoresRepo) Participants(eventIDs []int64) (map[int64][]*sports.Participant, error) {
+	if len(eventIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.db.Query(
+		`SELECT id, event_id, name, side FROM participants WHERE event_id IN (`+placeholders(len(eventIDs))+`) ORDER BY event_id, side, id`,
+		int64Args(eventIDs)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	participants := make(map[int64][]*sports.Participant)
+	for rows.Next() {
+		var participant sports.Participant
+		if err := rows.Scan(&participant.Id, &participant.EventId, &participant.Name, &participant.Side); err != nil {
+			return nil, err
+		}
+
+		participants[participant.EventId] = append(participants[participant.EventId], &participant)
+	}
+
+	return participants, rows.Err()
+}
+
+func (r *scoresRepo) Scores(eventIDs []int64) (map[int64]*sports.Score, error) {
+	if len(eventIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.db.Query(
+		`SELECT e.id, e.start_time, e.end_time, e.status_override, IFNULL(t.period_type, 0), IFNULL(t.scoring, 0) FROM sports e
+		LEFT JOIN sport_types t ON t.id = e.sport_id WHERE e.id IN (`+placeholders(len(eventIDs))+`)`,
+		int64Args(eventIDs)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.clock()
+	scores := make(map[int64]*sports.Score)
+	scoring := make(map[int64]sports.Scoring)
+
+	for rows.Next() {
+		var (
+			score      sports.Score
+			start, end time.Time
+			override   sports.EventStatus
+			rule       sports.Scoring
+		)
+
+		if err := rows.Scan(&score.EventId, &start, &end, &override, &score.PeriodType, &rule); err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		score.Final = eventStatus(override, start, end, now) == sports.EventStatus_EVENT_STATUS_FINISHED
+		scores[score.EventId] = &score
+		scoring[score.EventId] = rule
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.scanPeriods(scores, eventIDs); err != nil {
+		return nil, err
+	}
+
+	for eventID, score := range scores {
+		score.Totals = totals(score.Periods)
+		if score.Final {
+			score.WinnerId = winner(scoring[eventID], score)
+		}
+	}
+
+	return scores, nil
+}
+
+// scanPeriods adds the periods played to the scores of the given events.
+func (r *scoresRepo) scanPeriods(scores map[int64]*sports.Score, eventIDs []int64) error {
+	rows, err := r.db.Query(
+		`SELECT event_id, participant_id, period, points FROM scores WHERE event_id IN (`+placeholders(len(eventIDs))+`)
+		ORDER BY event_id, period, participant_id`,
+		int64Args(eventIDs)...,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			eventID int64
+			number  int32
+			points  sports.ParticipantScore
+		)
+
+		if err := rows.Scan(&eventID, &points.ParticipantId, &number, &points.Points); err != nil {
+			return err
+		}
+
+		score, ok := scores[eventID]
+		if !ok {
+			continue
+		}
+
+		if n := len(score.Periods); n == 0 || score.Periods[n-1].Number != number {
+			score.Periods = append(score.Periods, &sports.PeriodScore{Number: number})
+		}
+
+		period := score.Periods[len(score.Periods)-1]
+		period.Scores = append(period.Scores, &points)
+	}
+
+	return rows.Err()
+}
+
+// totals adds up the points of each participant over every period, in the order participants are scored in.
+func totals(periods []*sports.PeriodScore) []*sports.ParticipantScore {
+	var (
+		all  []*sports.ParticipantScore
+		byID = make(map[int64]*sports.ParticipantScore)
+	)
+
+	for _, period := range periods {
+		for _, points := range period.Scores {
+			total, ok := byID[points.ParticipantId]
+			if !ok {
+				total = &sports.ParticipantScore{ParticipantId: points.ParticipantId}
+				byID[points.ParticipantId] = total
+				all = append(all, total)
+			}
+
+			total.Points += points.Points
+		}
+	}
+
+	return all
+}
+
+// winner decides the participant that won a final score by the scoring of its sport, 0 means a draw or no score.
+func winner(scoring sports.Scoring, score *sports.Score) int64 {
+	switch scoring {
+	case sports.Scoring_SCORING_HIGHEST_TOTAL:
+		return best(score.Totals, func(a, b int64) bool { return a > b })
+	case sports.Scoring_SCORING_LOWEST_TOTAL:
+		return best(score.Totals, func(a, b int64) bool { return a < b })
+	case sports.Scoring_SCORING_MOST_PERIODS:
+		won := make(map[int64]int64)
+		for _, period := range score.Periods {
+			if id := best(period.Scores, func(a, b int64) bool { return a > b }); id != 0 {
+				won[id]++
+			}
+		}
+
+		periodsWon := make([]*sports.ParticipantScore, 0, len(score.Totals))
+		for _, total := range score.Totals {
+			periodsWon = append(periodsWon, &sports.ParticipantScore{ParticipantId: total.ParticipantId, Points: won[total.ParticipantId]})
+		}
+
+		return best(periodsWon, func(a, b int64) bool { return a > b })
+	}
+
+	return 0
+}
+
+// best returns the participant whose points beat everyone else's, or 0 when the best points are tied.
+func best(scores []*sports.ParticipantScore, beats func(a, b int64) bool) int64 {
+	var (
+		leader *sports.ParticipantScore
+		tied   bool
+	)
+
+	for _, score := range scores {
+		switch {
+		case leader == nil || beats(score.Points, leader.Points):
+			leader, tied = score, false
+		case !beats(leader.Points, score.Points):
+			tied = true
+		}
+	}
+
+	if leader == nil || tied {
+		return 0
+	}
+
+	return leader.ParticipantId
+}
+
+// placeholders returns n comma separated query placeholders.
+func placeholders(n int) string {
+	return strings.Repeat("?,", n-1) + "?"
+}
+
+// int64Args converts ids to query arguments.
+func int64Args(ids []int64) []interface{} {
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	return args
+}
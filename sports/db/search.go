@@ -0,0 +1,87 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidQuery is returned when a search query holds no terms that can be searched for.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// seedSearch creates the sports_fts index over event names and locations, and the triggers keeping it in sync.
+// FTS5 is only compiled into the sqlite driver when building with -tags sqlite_fts5.
+func (s *sportsRepo) seedSearch() error {
+	created, err := createSearchTable(s.db, "sports_fts", "name, location")
+	if err != nil {
+		return err
+	}
+
+	triggers := []string{
+		`CREATE TRIGGER IF NOT EXISTS sports_fts_insert AFTER INSERT ON sports BEGIN
+			INSERT INTO sports_fts(rowid, name, location) VALUES (new.id, new.name, new.location);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS sports_fts_update AFTER UPDATE OF name, location ON sports BEGIN
+			DELETE FROM sports_fts WHERE rowid = old.id;
+			INSERT INTO sports_fts(rowid, name, location) VALUES (new.id, new.name, new.location);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS sports_fts_delete AFTER DELETE ON sports BEGIN
+			DELETE FROM sports_fts WHERE rowid = old.id;
+		END`,
+	}
+
+	for _, trigger := range triggers {
+		if _, err := s.db.Exec(trigger); err != nil {
+			return err
+		}
+	}
+
+	if !created {
+		return nil
+	}
+
+	// Events stored before the index existed are indexed once, the triggers keep it in sync from then on.
+	_, err = s.db.Exec(`INSERT INTO sports_fts(rowid, name, location) SELECT id, name, location FROM sports`)
+
+	return err
+}
+
+// createSearchTable creates an FTS5 table over the given columns when it is missing, reporting whether it was created.
+func createSearchTable(db *sql.DB, table, columns string) (bool, error) {
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?`, table).Scan(&count); err != nil {
+		return false, err
+	}
+
+	if count > 0 {
+		return false, nil
+	}
+
+	if _, err := db.Exec(`CREATE VIRTUAL TABLE ` + table + ` USING fts5(` + columns + `)`); err != nil {
+		return false, fmt.Errorf("creating %s, the binary must be built with -tags sqlite_fts5: %w", table, err)
+	}
+
+	return true, nil
+}
+
+// matchQuery turns free text into an FTS5 query matching rows that hold every term as a prefix.
+// Terms are quoted so the FTS5 query syntax is searched for literally rather than interpreted.
+func matchQuery(text string) (string, error) {
+	var terms []string
+	for _, term := range strings.Fields(text) {
+		// Terms without letters or digits tokenize to nothing, so they can't match.
+		if strings.IndexFunc(term, func(c rune) bool { return unicode.IsLetter(c) || unicode.IsNumber(c) }) < 0 {
+			continue
+		}
+
+		terms = append(terms, `"`+strings.ReplaceAll(term, `"`, `""`)+`"*`)
+	}
+
+	if len(terms) == 0 {
+		return "", fmt.Errorf("%w: %q holds no letters or digits", ErrInvalidQuery, text)
+	}
+
+	return strings.Join(terms, " "), nil
+}
@@ -3,17 +3,46 @@ package db
 import (
 	"database/sql"
 	"errors"
-	"strconv"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	// Embeds the time zone database, so venue time zones load on hosts without one.
+	_ "time/tzdata"
+
 	_ "github.com/mattn/go-sqlite3"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"git.neds.sh/matty/entain/sports/proto/sports"
 )
 
+var (
+	// ErrInvalidOrderColumn is returned when events are ordered by a column that isn't an event field.
+	ErrInvalidOrderColumn = errors.New("invalid order column")
+
+	// ErrInvalidOrderDirection is returned when events are ordered in a direction other than asc or desc.
+	ErrInvalidOrderDirection = errors.New("invalid order direction")
+
+	// ErrEventNotFound is returned when no event has the requested id.
+	ErrEventNotFound = errors.New("event not found")
+)
+
+// eventOrderColumns allow-lists the columns events can be ordered by, nothing else reaches ORDER BY.
+var eventOrderColumns = map[string]bool{
+	"id":                    true,
+	"name":                  true,
+	"result":                true,
+	"location":              true,
+	"visible":               true,
+	"start_time":            true,
+	"end_time":              true,
+	"advertised_start_time": true,
+	"sport_id":              true,
+	"competition_id":        true,
+	"venue_id":              true,
+}
+
 // SportsRepo provides repository access to sports.
 type SportsRepo interface {
 	// Init will initialise our sports repository.
@@ -21,16 +50,23 @@ type SportsRepo interface {
 
 	// List will return a list of events.
 	List(filter *sports.ListEventsRequestFilter) ([]*sports.Event, error)
+
+	// Get will return an event by id whatever its visibility, or ErrEventNotFound when it doesn't exist.
+	Get(id int64) (*sports.Event, error)
+
+	// SetStatus will override the status of an event, EVENT_STATUS_UNSPECIFIED clears the override.
+	SetStatus(id int64, status sports.EventStatus) (*sports.Event, error)
 }
 
 type sportsRepo struct {
-	db   *sql.DB
-	init sync.Once
+	db    *sql.DB
+	clock Clock
+	init  sync.Once
 }
 
-// NewSportsRepo creates a new sports repository.
-func NewSportsRepo(db *sql.DB) SportsRepo {
-	return &sportsRepo{db: db}
+// NewSportsRepo creates a new sports repository, reading the current time from clock.
+func NewSportsRepo(db *sql.DB, clock Clock) SportsRepo {
+	return &sportsRepo{db: db, clock: clock}
 }
 
 // Init prepares the sport repository dummy data.
@@ -52,62 +88,184 @@ func (s *sportsRepo) List(filter *sports.ListEventsRequestFilter) ([]*sports.Eve
 		args  []interface{}
 	)
 
+	now := s.clock()
 	query = getEventQueries()[eventsList]
-	query, args = s.applyFilter(query, filter)
+	query, args, err = s.applyFilter(query, filter, now)
+	if err != nil {
+		return nil, err
+	}
 
 	rows, err := s.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.scanSportsEvent(rows)
+	return s.scanSportsEvent(rows, now)
+}
+
+func (s *sportsRepo) Get(id int64) (*sports.Event, error) {
+	rows, err := s.db.Query(getEventQueries()[eventsList]+" WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.scanSportsEvent(rows, s.clock())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return nil, ErrEventNotFound
+	}
+
+	return events[0], nil
+}
+
+func (s *sportsRepo) SetStatus(id int64, status sports.EventStatus) (*sports.Event, error) {
+	if !statusOverrides[status] {
+		return nil, fmt.Errorf("%w: %v follows from the event's start and end times", ErrInvalidStatusOverride, status)
+	}
+
+	result, err := s.db.Exec(`UPDATE sports SET status_override = ? WHERE id = ?`, status, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		if err == nil {
+			err = ErrEventNotFound
+		}
+
+		return nil, err
+	}
+
+	return s.Get(id)
 }
 
-func (s *sportsRepo) applyFilter(query string, filter *sports.ListEventsRequestFilter) (string, []interface{}) {
+func (s *sportsRepo) applyFilter(query string, filter *sports.ListEventsRequestFilter, now time.Time) (string, []interface{}, error) {
 	var (
 		clauses []string
 		args    []interface{}
 	)
 
 	if filter == nil {
-		return query, args
+		return query, args, nil
+	}
+
+	if filter.Column != "" && !eventOrderColumns[filter.Column] {
+		return "", nil, fmt.Errorf("%w: %q is not a sortable event field", ErrInvalidOrderColumn, filter.Column)
+	}
+
+	direction := strings.ToUpper(filter.OrderBy)
+	if direction != "" && direction != "ASC" && direction != "DESC" {
+		return "", nil, fmt.Errorf("%w: %q is neither asc nor desc", ErrInvalidOrderDirection, filter.OrderBy)
+	}
+
+	searching := strings.TrimSpace(filter.Query) != ""
+	if searching {
+		match, err := matchQuery(filter.Query)
+		if err != nil {
+			return "", nil, err
+		}
+
+		// Matches are joined rather than filtered with IN, so their rank can order events by relevance.
+		query = "WITH matches AS (SELECT rowid AS event_id, rank FROM sports_fts WHERE sports_fts MATCH ?)" + query + " JOIN matches ON event_id = id"
+		args = append(args, match)
 	}
 
 	if filter.Id > 0 {
-		id := strconv.Itoa(int(filter.Id))
-		clauses = append(clauses, "id = "+id+" and")
+		clauses = append(clauses, "id = ?")
+		args = append(args, filter.Id)
+	}
+
+	if clause := visibilityClause(filterVisibility(filter)); clause != "" {
+		clauses = append(clauses, clause)
+	}
+
+	if len(filter.SportIds) > 0 {
+		clauses = append(clauses, "sport_id IN ("+strings.Repeat("?,", len(filter.SportIds)-1)+"?)")
+
+		for _, id := range filter.SportIds {
+			args = append(args, id)
+		}
+	}
+
+	if len(filter.CompetitionIds) > 0 {
+		clauses = append(clauses, "competition_id IN ("+strings.Repeat("?,", len(filter.CompetitionIds)-1)+"?)")
+
+		for _, id := range filter.CompetitionIds {
+			args = append(args, id)
+		}
 	}
 
-	if filter.Visible == true {
-		clauses = append(clauses, "visible = 1")
-	} else {
-		clauses = append(clauses, "visible = 0")
+	if len(filter.VenueIds) > 0 {
+		clauses = append(clauses, "venue_id IN ("+strings.Repeat("?,", len(filter.VenueIds)-1)+"?)")
+
+		for _, id := range filter.VenueIds {
+			args = append(args, id)
+		}
 	}
 
-	if len(clauses) != 0 && len(filter.Column) == 0 && len(filter.OrderBy) == 0 {
-		query += " WHERE " + strings.Join(clauses, " ")
+	if len(filter.Statuses) > 0 {
+		clauses = append(clauses, statusExpr+" IN ("+strings.Repeat("?,", len(filter.Statuses)-1)+"?)")
+		// Times are stored in UTC and compared as text, so now is bound in UTC as well.
+		args = append(args, now.UTC(), now.UTC())
+
+		for _, status := range filter.Statuses {
+			args = append(args, status)
+		}
 	}
 
-	if len(filter.Column) > 0 && len(filter.OrderBy) > 0 {
-		clauses = append(clauses, "ORDER BY "+filter.Column+" "+filter.OrderBy)
-		query += " WHERE " + strings.Join(clauses, " ")
+	// Stored times are all UTC, so they compare as text against UTC bounds.
+	clauses, args = appendTimeRange(clauses, args, "advertised_start_time", filter.AdvertisedStartFrom, filter.AdvertisedStartTo)
+	clauses, args = appendTimeRange(clauses, args, "start_time", filter.StartTimeFrom, filter.StartTimeTo)
+	clauses, args = appendTimeRange(clauses, args, "end_time", filter.EndTimeFrom, filter.EndTimeTo)
+
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	if len(filter.Column) > 0 && len(direction) > 0 {
+		query += " ORDER BY " + filter.Column + " " + direction
+	} else if searching {
+		query += " ORDER BY rank ASC, id ASC"
 	}
 
 	// check sql correct or not
 	// log.Println(filter, query)
-	return query, args
+	return query, args, nil
+}
+
+// appendTimeRange adds the clauses limiting column to the window [from, to), either bound may be nil.
+func appendTimeRange(clauses []string, args []interface{}, column string, from, to *timestamppb.Timestamp) ([]string, []interface{}) {
+	if from != nil {
+		clauses = append(clauses, column+" >= ?")
+		args = append(args, from.AsTime())
+	}
+
+	if to != nil {
+		clauses = append(clauses, column+" < ?")
+		args = append(args, to.AsTime())
+	}
+
+	return clauses, args
 }
 
-func (s *sportsRepo) scanSportsEvent(rows *sql.Rows) ([]*sports.Event, error) {
+// scanSportsEvent scans events, deriving their status at now and their local start time from their venue.
+func (s *sportsRepo) scanSportsEvent(rows *sql.Rows, now time.Time) ([]*sports.Event, error) {
 	var allEvents []*sports.Event
 
+	locations := make(map[string]*time.Location)
+
 	for rows.Next() {
 		var event sports.Event
 		var advertisedStart time.Time
 		var eventStart time.Time
 		var eventEnd time.Time
+		var override sports.EventStatus
+		var timezone string
 
-		if err := rows.Scan(&event.Id, &event.Name, &event.Result, &event.Location, &event.Visible, &eventStart, &eventEnd, &advertisedStart); err != nil {
+		if err := rows.Scan(&event.Id, &event.Name, &event.Result, &event.Location, &event.Visible, &eventStart, &eventEnd, &advertisedStart, &event.SportId, &event.CompetitionId, &override, &event.VenueId, &timezone); err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return nil, nil
 			}
@@ -117,13 +275,21 @@ func (s *sportsRepo) scanSportsEvent(rows *sql.Rows) ([]*sports.Event, error) {
 
 		event.StartTime = timestamppb.New(eventStart)
 		event.EndTime = timestamppb.New(eventEnd)
-		ts := timestamppb.New(advertisedStart)
-		event.AdvertisedStartTime = ts
+		event.AdvertisedStartTime = timestamppb.New(advertisedStart)
+		event.Status = eventStatus(override, eventStart, eventEnd, now)
+
+		if timezone != "" {
+			location, ok := locations[timezone]
+			if !ok {
+				var err error
+				if location, err = time.LoadLocation(timezone); err != nil {
+					return nil, err
+				}
+
+				locations[timezone] = location
+			}
 
-		if time.Now().Unix() > ts.AsTime().Unix() {
-			event.Status = "CLOSED"
-		} else {
-			event.Status = "OPEN"
+			event.LocalAdvertisedStartTime = advertisedStart.In(location).Format(time.RFC3339)
 		}
 
 		allEvents = append(allEvents, &event)
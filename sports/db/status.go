@@ -0,0 +1,42 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// ErrInvalidStatusOverride is returned when an event is set to a status that is only ever derived from its times.
+var ErrInvalidStatusOverride = errors.New("invalid status override")
+
+// statusOverrides are the statuses an event can be set to by hand, UNSPECIFIED clears the override.
+var statusOverrides = map[sports.EventStatus]bool{
+	sports.EventStatus_EVENT_STATUS_UNSPECIFIED: true,
+	sports.EventStatus_EVENT_STATUS_SUSPENDED:   true,
+	sports.EventStatus_EVENT_STATUS_POSTPONED:   true,
+	sports.EventStatus_EVENT_STATUS_CANCELLED:   true,
+}
+
+// statusExpr is the SQL equivalent of eventStatus, the current time is bound in UTC to both of its placeholders.
+var statusExpr = fmt.Sprintf(
+	`CASE WHEN status_override != 0 THEN status_override WHEN ? < start_time THEN %d WHEN ? < end_time THEN %d ELSE %d END`,
+	sports.EventStatus_EVENT_STATUS_SCHEDULED,
+	sports.EventStatus_EVENT_STATUS_IN_PLAY,
+	sports.EventStatus_EVENT_STATUS_FINISHED,
+)
+
+// eventStatus derives the status of an event at now from its actual start and end times, an override takes precedence over them.
+func eventStatus(override sports.EventStatus, start, end, now time.Time) sports.EventStatus {
+	switch {
+	case override != sports.EventStatus_EVENT_STATUS_UNSPECIFIED:
+		return override
+	case now.Before(start):
+		return sports.EventStatus_EVENT_STATUS_SCHEDULED
+	case now.Before(end):
+		return sports.EventStatus_EVENT_STATUS_IN_PLAY
+	}
+
+	return sports.EventStatus_EVENT_STATUS_FINISHED
+}
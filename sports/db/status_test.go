@@ -0,0 +1,73 @@
+package db
+
+import (
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+func TestListStatusesInLocalTime(t *testing.T) {
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sportsDB, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "sports.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sportsDB.Close()
+
+	// The clock reads in a time zone ahead of UTC, as it does on a host outside UTC.
+	var now time.Time
+	repo := NewSportsRepo(sportsDB, func() time.Time { return now })
+
+	for _, r := range []interface{ Init() error }{NewTaxonomyRepo(sportsDB), NewVenuesRepo(sportsDB), repo} {
+		if err := r.Init(); err != nil {
+			if strings.Contains(err.Error(), "sqlite_fts5") {
+				t.Skip("search needs the sqlite driver built with -tags sqlite_fts5")
+			}
+
+			t.Fatal(err)
+		}
+	}
+
+	var start, end time.Time
+	if err := sportsDB.QueryRow(`SELECT start_time, end_time FROM sports WHERE id = 1`).Scan(&start, &end); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[sports.EventStatus]time.Time{
+		sports.EventStatus_EVENT_STATUS_SCHEDULED: start.Add(-time.Hour),
+		sports.EventStatus_EVENT_STATUS_IN_PLAY:   end.Add(-time.Hour),
+		sports.EventStatus_EVENT_STATUS_FINISHED:  end.Add(time.Hour),
+	}
+
+	for status, at := range tests {
+		t.Run(status.String(), func(t *testing.T) {
+			now = at.In(sydney)
+
+			events, err := repo.List(&sports.ListEventsRequestFilter{Statuses: []sports.EventStatus{status}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			found := false
+			for _, event := range events {
+				if event.Status != status {
+					t.Errorf("Unexpected event %d with status %v (expected %v)", event.Id, event.Status, status)
+				}
+
+				found = found || event.Id == 1
+			}
+
+			if !found {
+				t.Errorf("Expected event 1 to be %v at %s", status, now)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// TaxonomyRepo provides repository access to the sports, competitions and seasons events are organised by.
+// The events table is named sports, so sports themselves are stored in sport_types.
+type TaxonomyRepo interface {
+	// Init will initialise our taxonomy repository.
+	Init() error
+
+	// ListSports will return every sport.
+	ListSports() ([]*sports.Sport, error)
+
+	// ListCompetitions will return a list of competitions along with their seasons.
+	ListCompetitions(filter *sports.ListCompetitionsRequestFilter) ([]*sports.Competition, error)
+}
+
+type taxonomyRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// NewTaxonomyRepo creates a new taxonomy repository.
+func NewTaxonomyRepo(db *sql.DB) TaxonomyRepo {
+	return &taxonomyRepo{db: db}
+}
+
+// Init prepares the taxonomy repository dummy data.
+func (t *taxonomyRepo) Init() error {
+	var err error
+
+	t.init.Do(func() {
+		// For test/example purposes, we seed the DB with the sports and competitions events belong to.
+		err = t.seed()
+	})
+
+	return err
+}
+
+func (t *taxonomyRepo) ListSports() ([]*sports.Sport, error) {
+	rows, err := t.db.Query(`SELECT id, name, period_type, scoring FROM sport_types ORDER BY name, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var all []*sports.Sport
+
+	for rows.Next() {
+		var sport sports.Sport
+
+		if err := rows.Scan(&sport.Id, &sport.Name, &sport.PeriodType, &sport.Scoring); err != nil {
+			return nil, err
+		}
+
+		all = append(all, &sport)
+	}
+
+	return all, rows.Err()
+}
+
+func (t *taxonomyRepo) ListCompetitions(filter *sports.ListCompetitionsRequestFilter) ([]*sports.Competition, error) {
+	query := `SELECT id, sport_id, name, country FROM competitions`
+
+	var args []interface{}
+	if ids := filter.GetSportIds(); len(ids) > 0 {
+		query += " WHERE sport_id IN (" + strings.Repeat("?,", len(ids)-1) + "?)"
+
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+
+	rows, err := t.db.Query(query+" ORDER BY sport_id, name, id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var competitions []*sports.Competition
+	byID := make(map[int64]*sports.Competition)
+
+	for rows.Next() {
+		var competition sports.Competition
+
+		if err := rows.Scan(&competition.Id, &competition.SportId, &competition.Name, &competition.Country); err != nil {
+			return nil, err
+		}
+
+		competitions = append(competitions, &competition)
+		byID[competition.Id] = &competition
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(competitions) == 0 {
+		return nil, nil
+	}
+
+	return competitions, t.attachSeasons(byID)
+}
+
+// attachSeasons loads the seasons of the given competitions, keyed by id, oldest first.
+func (t *taxonomyRepo) attachSeasons(competitions map[int64]*sports.Competition) error {
+	args := make([]interface{}, 0, len(competitions))
+	for id := range competitions {
+		args = append(args, id)
+	}
+
+	rows, err := t.db.Query(
+		`SELECT id, competition_id, name, start_date, end_date FROM seasons
+		WHERE competition_id IN (`+strings.Repeat("?,", len(args)-1)+`?) ORDER BY start_date, id`,
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var season sports.Season
+
+		if err := rows.Scan(&season.Id, &season.CompetitionId, &season.Name, &season.StartDate, &season.EndDate); err != nil {
+			return err
+		}
+
+		competition := competitions[season.CompetitionId]
+		competition.Seasons = append(competition.Seasons, &season)
+	}
+
+	return rows.Err()
+}
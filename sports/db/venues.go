@@ -0,0 +1,85 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+
+	"git.neds.sh/matty/entain/sports/proto/sports"
+)
+
+// VenuesRepo provides repository access to the stadiums, courts and courses events are held at.
+type VenuesRepo interface {
+	// Init will initialise our venues repository.
+	Init() error
+
+	// List will return a list of venues.
+	List(filter *sports.ListVenuesRequestFilter) ([]*sports.Venue, error)
+}
+
+type venuesRepo struct {
+	db   *sql.DB
+	init sync.Once
+}
+
+// NewVenuesRepo creates a new venues repository.
+func NewVenuesRepo(db *sql.DB) VenuesRepo {
+	return &venuesRepo{db: db}
+}
+
+// Init prepares the venues repository dummy data.
+func (v *venuesRepo) Init() error {
+	var err error
+
+	v.init.Do(func() {
+		// For test/example purposes, we seed the DB with the venues of the seeded competitions.
+		err = v.seed()
+	})
+
+	return err
+}
+
+func (v *venuesRepo) List(filter *sports.ListVenuesRequestFilter) ([]*sports.Venue, error) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+
+	if ids := filter.GetIds(); len(ids) > 0 {
+		clauses = append(clauses, "id IN ("+strings.Repeat("?,", len(ids)-1)+"?)")
+
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+
+	if country := filter.GetCountry(); country != "" {
+		clauses = append(clauses, "country = ?")
+		args = append(args, strings.ToUpper(country))
+	}
+
+	query := `SELECT id, name, city, country, latitude, longitude, timezone FROM venues`
+	if len(clauses) != 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	rows, err := v.db.Query(query+" ORDER BY name, id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var venues []*sports.Venue
+
+	for rows.Next() {
+		var venue sports.Venue
+
+		if err := rows.Scan(&venue.Id, &venue.Name, &venue.City, &venue.Country, &venue.Latitude, &venue.Longitude, &venue.Timezone); err != nil {
+			return nil, err
+		}
+
+		venues = append(venues, &venue)
+	}
+
+	return venues, rows.Err()
+}
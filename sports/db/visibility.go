@@ -0,0 +1,29 @@
+package db
+
+import "git.neds.sh/matty/entain/sports/proto/sports"
+
+// filterVisibility resolves the visibility a filter selects, falling back to the deprecated visible flag when it is left as any.
+// A false visible flag selects every event, as it does for races.
+func filterVisibility(filter *sports.ListEventsRequestFilter) sports.Visibility {
+	if visibility := filter.GetVisibility(); visibility != sports.Visibility_VISIBILITY_ANY {
+		return visibility
+	}
+
+	if filter.GetVisible() {
+		return sports.Visibility_VISIBILITY_VISIBLE
+	}
+
+	return sports.Visibility_VISIBILITY_ANY
+}
+
+// visibilityClause returns the clause selecting events with the given visibility, it is empty for VISIBILITY_ANY.
+func visibilityClause(visibility sports.Visibility) string {
+	switch visibility {
+	case sports.Visibility_VISIBILITY_VISIBLE:
+		return "visible = 1"
+	case sports.Visibility_VISIBILITY_HIDDEN:
+		return "visible = 0"
+	}
+
+	return ""
+}
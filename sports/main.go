@@ -5,6 +5,7 @@ import (
 	"flag"
 	"log"
 	"net"
+	"time"
 
 	"git.neds.sh/matty/entain/sports/db"
 	"git.neds.sh/matty/entain/sports/proto/sports"
@@ -36,16 +37,39 @@ func run() error {
 		return err
 	}
 
-	sportsRepo := db.NewSportsRepo(sportsDB)
+	// Events are assigned to the seeded competitions, so the taxonomy is initialised first.
+	taxonomyRepo := db.NewTaxonomyRepo(sportsDB)
+	if err := taxonomyRepo.Init(); err != nil {
+		return err
+	}
+
+	// Event local start times are read in the time zone of their venue.
+	venuesRepo := db.NewVenuesRepo(sportsDB)
+	if err := venuesRepo.Init(); err != nil {
+		return err
+	}
+
+	sportsRepo := db.NewSportsRepo(sportsDB, time.Now)
 	if err := sportsRepo.Init(); err != nil {
 		return err
 	}
 
-	grpcServer := grpc.NewServer()
+	scoresRepo := db.NewScoresRepo(sportsDB, time.Now)
+	if err := scoresRepo.Init(); err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(service.UnaryErrorInterceptor),
+		grpc.StreamInterceptor(service.StreamErrorInterceptor),
+	)
 	sports.RegisterSportsServer(
 		grpcServer,
 		service.NewSportsService(
 			sportsRepo,
+			taxonomyRepo,
+			scoresRepo,
+			venuesRepo,
 		),
 	)
 
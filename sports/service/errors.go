@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// invalidArgument returns an InvalidArgument status whose details carry a field violation for the offending request field,
+// so clients can point at the field without parsing the message.
+func invalidArgument(field, format string, args ...interface{}) error {
+	description := fmt.Sprintf(format, args...)
+
+	st, err := status.New(codes.InvalidArgument, description).WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, description)
+	}
+
+	return st.Err()
+}
+
+// sanitizeError passes status errors through and replaces anything else, such as database failures,
+// with an Internal status that doesn't leak the underlying message. The original error is logged instead.
+func sanitizeError(method string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "request canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "request deadline exceeded")
+	}
+
+	log.Printf("%s failed: %v\n", method, err)
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+// UnaryErrorInterceptor sanitizes the errors returned by unary RPCs.
+func UnaryErrorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+
+	return resp, sanitizeError(info.FullMethod, err)
+}
+
+// StreamErrorInterceptor sanitizes the errors returned by streaming RPCs.
+func StreamErrorInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return sanitizeError(info.FullMethod, handler(srv, ss))
+}
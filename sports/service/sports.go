@@ -1,31 +1,229 @@
 package service
 
 import (
+	"errors"
+
 	"git.neds.sh/matty/entain/sports/db"
 	"git.neds.sh/matty/entain/sports/proto/sports"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type SportsEvent interface {
 	// ListEvents will return a collection of sports events.
 	ListEvents(ctx context.Context, in *sports.ListEventsRequest) (*sports.ListEventsResponse, error)
+
+	// GetEvent will return a sports event by ID.
+	GetEvent(ctx context.Context, in *sports.GetEventRequest) (*sports.GetEventResponse, error)
+
+	// ListSports will return the sports events are organised by.
+	ListSports(ctx context.Context, in *sports.ListSportsRequest) (*sports.ListSportsResponse, error)
+
+	// ListCompetitions will return the competitions of sports.
+	ListCompetitions(ctx context.Context, in *sports.ListCompetitionsRequest) (*sports.ListCompetitionsResponse, error)
+
+	// GetEventScore will return the participants and score of a sports event.
+	GetEventScore(ctx context.Context, in *sports.GetEventScoreRequest) (*sports.GetEventScoreResponse, error)
+
+	// SetEventStatus will override the status of a sports event.
+	SetEventStatus(ctx context.Context, in *sports.SetEventStatusRequest) (*sports.SetEventStatusResponse, error)
+
+	// ListVenues will return a collection of the venues events are held at.
+	ListVenues(ctx context.Context, in *sports.ListVenuesRequest) (*sports.ListVenuesResponse, error)
 }
 
 // sportsService implements the sports interface.
 type sportsService struct {
-	sportsRepo db.SportsRepo
+	sportsRepo   db.SportsRepo
+	taxonomyRepo db.TaxonomyRepo
+	scoresRepo   db.ScoresRepo
+	venuesRepo   db.VenuesRepo
 }
 
 // NewSportsService instantiates and returns a new sportsService.
-func NewSportsService(sportsRepo db.SportsRepo) SportsEvent {
-	return &sportsService{sportsRepo}
+func NewSportsService(sportsRepo db.SportsRepo, taxonomyRepo db.TaxonomyRepo, scoresRepo db.ScoresRepo, venuesRepo db.VenuesRepo) SportsEvent {
+	return &sportsService{sportsRepo: sportsRepo, taxonomyRepo: taxonomyRepo, scoresRepo: scoresRepo, venuesRepo: venuesRepo}
 }
 
 func (s *sportsService) ListEvents(ctx context.Context, in *sports.ListEventsRequest) (*sports.ListEventsResponse, error) {
+	filter := in.GetFilter()
+	windows := []struct {
+		name     string
+		from, to *timestamppb.Timestamp
+	}{
+		{"filter.advertised_start", filter.GetAdvertisedStartFrom(), filter.GetAdvertisedStartTo()},
+		{"filter.start_time", filter.GetStartTimeFrom(), filter.GetStartTimeTo()},
+		{"filter.end_time", filter.GetEndTimeFrom(), filter.GetEndTimeTo()},
+	}
+
+	for _, window := range windows {
+		if err := validateTimeRange(window.name, window.from, window.to); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := sports.Visibility_name[int32(filter.GetVisibility())]; !ok {
+		return nil, invalidArgument("filter.visibility", "visibility %v is not a visibility", filter.GetVisibility())
+	}
+
+	for _, eventStatus := range filter.GetStatuses() {
+		if _, ok := sports.EventStatus_name[int32(eventStatus)]; !ok || eventStatus == sports.EventStatus_EVENT_STATUS_UNSPECIFIED {
+			return nil, invalidArgument("filter.statuses", "statuses contains %v which is not an event status", eventStatus)
+		}
+	}
+
 	sportsEventResult, err := s.sportsRepo.List(in.Filter)
 	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrInvalidOrderColumn):
+			return nil, invalidArgument("filter.column", "%v", err)
+		case errors.Is(err, db.ErrInvalidOrderDirection):
+			return nil, invalidArgument("filter.order_by", "%v", err)
+		case errors.Is(err, db.ErrInvalidQuery):
+			// The message quotes the query, so it mustn't be used as a format.
+			return nil, invalidArgument("filter.query", "%v", err)
+		}
+
+		return nil, err
+	}
+
+	if err := s.attachScores(sportsEventResult...); err != nil {
 		return nil, err
 	}
 
 	return &sports.ListEventsResponse{Events: sportsEventResult}, nil
 }
+
+func (s *sportsService) GetEvent(ctx context.Context, in *sports.GetEventRequest) (*sports.GetEventResponse, error) {
+	event, err := s.sportsRepo.Get(in.Id)
+	if err != nil {
+		if errors.Is(err, db.ErrEventNotFound) {
+			return nil, status.Errorf(codes.NotFound, "event %d not found", in.Id)
+		}
+
+		return nil, err
+	}
+
+	if err := s.attachScores(event); err != nil {
+		return nil, err
+	}
+
+	return &sports.GetEventResponse{Event: event}, nil
+}
+
+func (s *sportsService) GetEventScore(ctx context.Context, in *sports.GetEventScoreRequest) (*sports.GetEventScoreResponse, error) {
+	event, err := s.sportsRepo.Get(in.EventId)
+	if err != nil {
+		if errors.Is(err, db.ErrEventNotFound) {
+			return nil, status.Errorf(codes.NotFound, "event %d not found", in.EventId)
+		}
+
+		return nil, err
+	}
+
+	if err := s.attachScores(event); err != nil {
+		return nil, err
+	}
+
+	return &sports.GetEventScoreResponse{Participants: event.Participants, Score: event.Score}, nil
+}
+
+func (s *sportsService) SetEventStatus(ctx context.Context, in *sports.SetEventStatusRequest) (*sports.SetEventStatusResponse, error) {
+	if _, ok := sports.EventStatus_name[int32(in.Status)]; !ok {
+		return nil, invalidArgument("status", "status %v is not an event status", in.Status)
+	}
+
+	event, err := s.sportsRepo.SetStatus(in.Id, in.Status)
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrEventNotFound):
+			return nil, status.Errorf(codes.NotFound, "event %d not found", in.Id)
+		case errors.Is(err, db.ErrInvalidStatusOverride):
+			return nil, invalidArgument("status", "%v", err)
+		}
+
+		return nil, err
+	}
+
+	if err := s.attachScores(event); err != nil {
+		return nil, err
+	}
+
+	return &sports.SetEventStatusResponse{Event: event}, nil
+}
+
+// attachScores sets the participants and score of each event, loading them for every event at once.
+func (s *sportsService) attachScores(events ...*sports.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.Id)
+	}
+
+	participants, err := s.scoresRepo.Participants(ids)
+	if err != nil {
+		return err
+	}
+
+	scores, err := s.scoresRepo.Scores(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		event.Participants = participants[event.Id]
+		event.Score = scores[event.Id]
+	}
+
+	return nil
+}
+
+func (s *sportsService) ListSports(ctx context.Context, in *sports.ListSportsRequest) (*sports.ListSportsResponse, error) {
+	all, err := s.taxonomyRepo.ListSports()
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.ListSportsResponse{Sports: all}, nil
+}
+
+func (s *sportsService) ListCompetitions(ctx context.Context, in *sports.ListCompetitionsRequest) (*sports.ListCompetitionsResponse, error) {
+	competitions, err := s.taxonomyRepo.ListCompetitions(in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.ListCompetitionsResponse{Competitions: competitions}, nil
+}
+
+func (s *sportsService) ListVenues(ctx context.Context, in *sports.ListVenuesRequest) (*sports.ListVenuesResponse, error) {
+	venues, err := s.venuesRepo.List(in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sports.ListVenuesResponse{Venues: venues}, nil
+}
+
+// validateTimeRange checks the optional bounds of a time window, where from is inclusive and to exclusive.
+// field is the path of the window without its _from and _to suffixes.
+func validateTimeRange(field string, from, to *timestamppb.Timestamp) error {
+	if from != nil && from.CheckValid() != nil {
+		return invalidArgument(field+"_from", "%s_from is not a valid timestamp", field)
+	}
+
+	if to != nil && to.CheckValid() != nil {
+		return invalidArgument(field+"_to", "%s_to is not a valid timestamp", field)
+	}
+
+	if from != nil && to != nil && !from.AsTime().Before(to.AsTime()) {
+		return invalidArgument(field+"_from", "%s_from must be before %s_to", field, field)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -20,12 +21,29 @@ type Event struct {
 	EndTime             string `json:"endTime"`
 	AdvertisedStartTime string `json:"advertisedStartTime"`
 	Status              string `json:"status"`
+	SportID             string `json:"sportId"`
+	CompetitionID       string `json:"competitionId"`
+
+	VenueID                  string `json:"venueId"`
+	LocalAdvertisedStartTime string `json:"localAdvertisedStartTime"`
 }
 
 type listEventsResponse struct {
 	Events []Event `json:"events"`
 }
 
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Details []struct {
+		Type            string `json:"@type"`
+		FieldViolations []struct {
+			Field       string `json:"field"`
+			Description string `json:"description"`
+		} `json:"fieldViolations"`
+	} `json:"details"`
+}
+
 type listEventsTestCase struct {
 	name        string
 	url         string
@@ -40,7 +58,7 @@ const (
 	caseName3 = "Filtered visible true and advertised_start_time order by asc"
 	caseName4 = "Filtered visible true and start_time order by desc"
 	caseName5 = "Filtered visible true and end_time order by desc"
-	caseName6 = "Filtered advertised_start_time order by desc, status OPEN or CLOSED"
+	caseName6 = "Filtered advertised_start_time order by desc, status derived from start_time and end_time"
 	caseName7 = "Filtered visible true, location exists, and start_time less than end_time"
 	caseName8 = "Filtered visible true and name desc, and start_time less than end_time"
 	caseName9 = "Filtered visible true and id equals 68"
@@ -52,7 +70,7 @@ func TestListEvents(t *testing.T) {
 			name:        caseName1,
 			url:         apiHost + "v1/list-events",
 			filter:      map[string]interface{}{},
-			expectedLen: 49,
+			expectedLen: 100,
 		},
 		{
 			name:   caseName2,
@@ -210,8 +228,8 @@ func TestListEvents(t *testing.T) {
 						}
 					}
 
-					if !(v.Status == "OPEN" || v.Status == "CLOSED") {
-						t.Errorf("Unexpected filtered response status: %v (expected %v)", v.Status, "OPEN or CLOSED")
+					if expected := expectedStatus(v); v.Status != expected {
+						t.Errorf("Unexpected filtered response status: %v (expected %v)", v.Status, expected)
 						return
 					}
 				}
@@ -288,6 +306,796 @@ func TestListEvents(t *testing.T) {
 	}
 }
 
+func TestListEventsTimeRange(t *testing.T) {
+	all, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{"filter": map[string]interface{}{"visible": true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(all.Events) == 0 {
+		t.Fatal("Expected visible events")
+	}
+
+	// Use the start of the earliest visible event as the edge of the window.
+	var from time.Time
+	for _, v := range all.Events {
+		startTime, _ := time.Parse(time.RFC3339, v.StartTime)
+		if from.IsZero() || startTime.Before(from) {
+			from = startTime
+		}
+	}
+	to := from.Add(12 * time.Hour)
+
+	t.Run("Returns events starting within the window", func(t *testing.T) {
+		data := map[string]interface{}{
+			"filter": map[string]interface{}{
+				"visible":         true,
+				"start_time_from": from.Format(time.RFC3339),
+				"start_time_to":   to.Format(time.RFC3339),
+			},
+		}
+		resp, err := makePostRequest(apiHost+"v1/list-events", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(resp.Events) == 0 {
+			t.Fatal("Expected the earliest event within the window")
+		}
+
+		for _, v := range resp.Events {
+			startTime, _ := time.Parse(time.RFC3339, v.StartTime)
+			if startTime.Before(from) || !startTime.Before(to) {
+				t.Errorf("Unexpected event %s starting at %s outside [%s, %s)", v.ID, v.StartTime, from, to)
+			}
+		}
+	})
+
+	t.Run("Excludes events before the window", func(t *testing.T) {
+		data := map[string]interface{}{
+			"filter": map[string]interface{}{
+				"visible":               true,
+				"advertised_start_from": to.Format(time.RFC3339),
+			},
+		}
+		resp, err := makePostRequest(apiHost+"v1/list-events", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range resp.Events {
+			advertisedStart, _ := time.Parse(time.RFC3339, v.AdvertisedStartTime)
+			if advertisedStart.Before(to) {
+				t.Errorf("Unexpected event %s advertised to start at %s before %s", v.ID, v.AdvertisedStartTime, to)
+			}
+		}
+	})
+
+	t.Run("Rejects a window ending before it starts", func(t *testing.T) {
+		data := map[string]interface{}{
+			"filter": map[string]interface{}{
+				"end_time_from": to.Format(time.RFC3339),
+				"end_time_to":   from.Format(time.RFC3339),
+			},
+		}
+		if _, err := makePostRequest(apiHost+"v1/list-events", data); err == nil {
+			t.Error("Expected an inverted window to be rejected")
+		}
+	})
+}
+
+func TestListEventsInvalidOrder(t *testing.T) {
+	tests := map[string]struct {
+		filter map[string]interface{}
+		field  string
+	}{
+		"Rejects a column that isn't an event field": {
+			filter: map[string]interface{}{"column": "name; DROP TABLE sports", "order_by": "asc"},
+			field:  "filter.column",
+		},
+		"Rejects an unknown direction": {
+			filter: map[string]interface{}{"column": "name", "order_by": "sideways"},
+			field:  "filter.order_by",
+		},
+		"Quotes a column holding format verbs as given": {
+			filter: map[string]interface{}{"column": "na%sme", "order_by": "asc"},
+			field:  "filter.column",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			body, _ := json.Marshal(map[string]interface{}{"filter": tt.filter})
+			resp, err := http.Post(apiHost+"v1/list-events", "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+			}
+
+			var errResp errorResponse
+			if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+				t.Fatalf("Failed to decode JSON response: %v", err)
+			}
+
+			if len(errResp.Details) != 1 || len(errResp.Details[0].FieldViolations) != 1 || errResp.Details[0].FieldViolations[0].Field != tt.field {
+				t.Errorf("Unexpected error details: %+v (expected a violation of %s)", errResp.Details, tt.field)
+			}
+
+			if strings.Contains(errResp.Message, "%!") {
+				t.Errorf("Unexpected formatting directive in %q", errResp.Message)
+			}
+		})
+	}
+}
+
+func TestListEventsQuery(t *testing.T) {
+	// hasPrefixedWord reports whether any word of text starts with prefix, ignoring case.
+	hasPrefixedWord := func(text, prefix string) bool {
+		for _, word := range strings.Fields(strings.ToLower(text)) {
+			if strings.HasPrefix(word, prefix) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	t.Run("Matches names and locations", func(t *testing.T) {
+		resp, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{"filter": map[string]interface{}{"query": "Melbourne"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(resp.Events) == 0 {
+			t.Fatal("Expected events matching the query")
+		}
+
+		for _, v := range resp.Events {
+			if !hasPrefixedWord(v.Name, "melbourne") && !hasPrefixedWord(v.Location, "melbourne") {
+				t.Errorf("Unexpected event %s named %q at %q", v.ID, v.Name, v.Location)
+			}
+		}
+	})
+
+	t.Run("Keeps an explicit order", func(t *testing.T) {
+		resp, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{
+			"filter": map[string]interface{}{"query": "port", "column": "name", "order_by": "desc"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for k := 1; k < len(resp.Events); k++ {
+			if resp.Events[k-1].Name < resp.Events[k].Name {
+				t.Errorf("Unexpected event %q ordered before %q", resp.Events[k-1].Name, resp.Events[k].Name)
+			}
+		}
+	})
+
+	t.Run("Rejects a query without terms", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"filter": map[string]interface{}{"query": "&&"}})
+		resp, err := http.Post(apiHost+"v1/list-events", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestListEventsVisibility(t *testing.T) {
+	list := func(t *testing.T, filter map[string]interface{}) []Event {
+		resp, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{"filter": filter})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return resp.Events
+	}
+
+	all := list(t, map[string]interface{}{"visibility": "VISIBILITY_ANY"})
+	visible := list(t, map[string]interface{}{"visibility": "VISIBILITY_VISIBLE"})
+	hidden := list(t, map[string]interface{}{"visibility": "VISIBILITY_HIDDEN"})
+
+	t.Run("Splits every event into visible and hidden ones", func(t *testing.T) {
+		if len(visible) == 0 || len(hidden) == 0 || len(visible)+len(hidden) != len(all) {
+			t.Errorf("Unexpected counts: %d visible and %d hidden of %d events", len(visible), len(hidden), len(all))
+		}
+
+		for _, v := range visible {
+			if !v.Visible {
+				t.Errorf("Unexpected hidden event %s", v.ID)
+			}
+		}
+
+		for _, v := range hidden {
+			if v.Visible {
+				t.Errorf("Unexpected visible event %s", v.ID)
+			}
+		}
+	})
+
+	t.Run("Keeps the visible flag for older clients", func(t *testing.T) {
+		if events := list(t, map[string]interface{}{"visible": true}); len(events) != len(visible) {
+			t.Errorf("Unexpected number of events: %d (expected %d)", len(events), len(visible))
+		}
+
+		if events := list(t, map[string]interface{}{"visible": true, "visibility": "VISIBILITY_HIDDEN"}); len(events) != len(hidden) {
+			t.Errorf("Unexpected number of events when visibility wins: %d (expected %d)", len(events), len(hidden))
+		}
+	})
+
+	t.Run("Rejects an unknown visibility", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"filter": map[string]interface{}{"visibility": 7}})
+		resp, err := http.Post(apiHost+"v1/list-events", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestGetEvent(t *testing.T) {
+	getEvent := func(t *testing.T, id string) (*http.Response, *Event) {
+		resp, err := http.Get(apiHost + "v1/events/" + id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		var body struct {
+			Event *Event `json:"event"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&body)
+
+		return resp, body.Event
+	}
+
+	t.Run("Returns visible and hidden events", func(t *testing.T) {
+		tests := map[string]bool{"1": true, "3": false}
+
+		for id, visible := range tests {
+			resp, event := getEvent(t, id)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Unexpected status code for event %s: %d (expected %d)", id, resp.StatusCode, http.StatusOK)
+			}
+
+			if event == nil || event.ID != id || event.Visible != visible {
+				t.Errorf("Unexpected event %+v (expected id %s, visible %v)", event, id, visible)
+			}
+		}
+	})
+
+	t.Run("Returns not found for a missing event", func(t *testing.T) {
+		if resp, _ := getEvent(t, "999"); resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusNotFound)
+		}
+	})
+}
+
+func TestTaxonomy(t *testing.T) {
+	post := func(t *testing.T, url string, body, out interface{}) {
+		data, _ := json.Marshal(body)
+		resp, err := http.Post(url, "application/json", bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+		}
+
+		_ = json.NewDecoder(resp.Body).Decode(out)
+	}
+
+	type competition struct {
+		ID      string `json:"id"`
+		SportID string `json:"sportId"`
+		Name    string `json:"name"`
+		Seasons []struct {
+			CompetitionID string `json:"competitionId"`
+			StartDate     string `json:"startDate"`
+			EndDate       string `json:"endDate"`
+		} `json:"seasons"`
+	}
+
+	t.Run("Lists sports", func(t *testing.T) {
+		var resp struct {
+			Sports []struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"sports"`
+		}
+		post(t, apiHost+"v1/list-sports", map[string]interface{}{}, &resp)
+
+		if len(resp.Sports) == 0 {
+			t.Fatal("Expected sports")
+		}
+
+		for _, sport := range resp.Sports {
+			if sport.ID == "" || sport.Name == "" {
+				t.Errorf("Unexpected sport %+v", sport)
+			}
+		}
+	})
+
+	t.Run("Lists competitions of a sport with their seasons", func(t *testing.T) {
+		var resp struct {
+			Competitions []competition `json:"competitions"`
+		}
+		post(t, apiHost+"v1/list-competitions", map[string]interface{}{"filter": map[string]interface{}{"sport_ids": []int{1}}}, &resp)
+
+		if len(resp.Competitions) == 0 {
+			t.Fatal("Expected competitions of sport 1")
+		}
+
+		for _, c := range resp.Competitions {
+			if c.SportID != "1" {
+				t.Errorf("Unexpected competition %s of sport %s", c.Name, c.SportID)
+			}
+
+			if len(c.Seasons) == 0 {
+				t.Errorf("Expected seasons of competition %s", c.Name)
+			}
+
+			for _, season := range c.Seasons {
+				if season.CompetitionID != c.ID || season.StartDate >= season.EndDate {
+					t.Errorf("Unexpected season %+v of competition %s", season, c.ID)
+				}
+			}
+		}
+	})
+
+	t.Run("Filters events by sport and competition", func(t *testing.T) {
+		bySport, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{"filter": map[string]interface{}{"visible": true, "sport_ids": []int{1}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(bySport.Events) == 0 {
+			t.Fatal("Expected events of sport 1")
+		}
+
+		for _, v := range bySport.Events {
+			if v.SportID != "1" {
+				t.Errorf("Unexpected event %s of sport %s", v.ID, v.SportID)
+			}
+		}
+
+		byCompetition, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{"filter": map[string]interface{}{"visible": true, "competition_ids": []int{2}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range byCompetition.Events {
+			if v.CompetitionID != "2" || v.SportID != "1" {
+				t.Errorf("Unexpected event %s of competition %s and sport %s", v.ID, v.CompetitionID, v.SportID)
+			}
+		}
+	})
+}
+
+func TestGetEventScore(t *testing.T) {
+	type points struct {
+		ParticipantID string `json:"participantId"`
+		Points        string `json:"points"`
+	}
+
+	type scoreResponse struct {
+		Participants []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			Side string `json:"side"`
+		} `json:"participants"`
+		Score struct {
+			Periods []struct {
+				Number int      `json:"number"`
+				Scores []points `json:"scores"`
+			} `json:"periods"`
+			Totals   []points `json:"totals"`
+			Final    bool     `json:"final"`
+			WinnerID string   `json:"winnerId"`
+		} `json:"score"`
+	}
+
+	var sportsResp struct {
+		Sports []struct {
+			ID      string `json:"id"`
+			Scoring string `json:"scoring"`
+		} `json:"sports"`
+	}
+	resp, err := http.Post(apiHost+"v1/list-sports", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&sportsResp)
+	resp.Body.Close()
+
+	scoring := make(map[string]string)
+	for _, sport := range sportsResp.Sports {
+		scoring[sport.ID] = sport.Scoring
+	}
+
+	t.Run("Scores events by period with a computed winner", func(t *testing.T) {
+		// The seeded events are spread across every competition, so the first dozen cover every sport.
+		for id := 1; id <= 12; id++ {
+			eventID := strconv.Itoa(id)
+
+			resp, err := http.Get(apiHost + "v1/events/" + eventID + "/score")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var score scoreResponse
+			_ = json.NewDecoder(resp.Body).Decode(&score)
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Unexpected status code for event %s: %d (expected %d)", eventID, resp.StatusCode, http.StatusOK)
+			}
+
+			event, err := getEvent(eventID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(score.Participants) < 2 {
+				t.Errorf("Expected participants of event %s, got %+v", eventID, score.Participants)
+			}
+
+			totals := make(map[string]int)
+			wins := make(map[string]int)
+			for _, period := range score.Score.Periods {
+				if len(period.Scores) != len(score.Participants) {
+					t.Errorf("Unexpected scores of event %s in period %d: %+v", eventID, period.Number, period.Scores)
+				}
+
+				leader, most := "", -1
+				for _, p := range period.Scores {
+					n, _ := strconv.Atoi(p.Points)
+					totals[p.ParticipantID] += n
+					if n > most {
+						leader, most = p.ParticipantID, n
+					}
+				}
+				wins[leader]++
+			}
+
+			for _, total := range score.Score.Totals {
+				if n, _ := strconv.Atoi(total.Points); n != totals[total.ParticipantID] {
+					t.Errorf("Unexpected total %d of participant %s in event %s (expected %d)", n, total.ParticipantID, eventID, totals[total.ParticipantID])
+				}
+			}
+
+			// Draws have no winner.
+			if !score.Score.Final || score.Score.WinnerID == "0" {
+				continue
+			}
+
+			// The winner must beat every other participant by the scoring of the sport.
+			winner := score.Score.WinnerID
+			for participant := range totals {
+				if participant == winner {
+					continue
+				}
+
+				var beaten bool
+				switch scoring[event.SportID] {
+				case "SCORING_HIGHEST_TOTAL":
+					beaten = totals[winner] > totals[participant]
+				case "SCORING_LOWEST_TOTAL":
+					beaten = totals[winner] < totals[participant]
+				case "SCORING_MOST_PERIODS":
+					beaten = wins[winner] > wins[participant]
+				}
+
+				if !beaten {
+					t.Errorf("Unexpected winner %s of event %s scored %s, participant %s didn't lose", winner, eventID, scoring[event.SportID], participant)
+				}
+			}
+		}
+	})
+
+	t.Run("Returns not found for a missing event", func(t *testing.T) {
+		resp, err := http.Get(apiHost + "v1/events/999/score")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusNotFound)
+		}
+	})
+}
+
+func TestSetEventStatus(t *testing.T) {
+	setStatus := func(t *testing.T, id, status string) (*http.Response, *Event) {
+		body, _ := json.Marshal(map[string]interface{}{"status": status})
+		resp, err := http.Post(apiHost+"v1/events/"+id+":setStatus", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		var out struct {
+			Event *Event `json:"event"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&out)
+
+		return resp, out.Event
+	}
+
+	event, err := getEvent("4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	derived := expectedStatus(*event)
+
+	defer setStatus(t, "4", "EVENT_STATUS_UNSPECIFIED")
+
+	t.Run("Overrides the derived status", func(t *testing.T) {
+		resp, event := setStatus(t, "4", "EVENT_STATUS_CANCELLED")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+		}
+
+		if event.Status != "EVENT_STATUS_CANCELLED" {
+			t.Errorf("Unexpected status %s (expected EVENT_STATUS_CANCELLED)", event.Status)
+		}
+
+		cancelled, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{
+			"filter": map[string]interface{}{"visible": true, "statuses": []string{"EVENT_STATUS_CANCELLED"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(cancelled.Events) != 1 || cancelled.Events[0].ID != "4" {
+			t.Errorf("Unexpected cancelled events %+v (expected event 4)", cancelled.Events)
+		}
+	})
+
+	t.Run("Clears the override", func(t *testing.T) {
+		resp, event := setStatus(t, "4", "EVENT_STATUS_UNSPECIFIED")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+		}
+
+		if event.Status != derived {
+			t.Errorf("Unexpected status %s (expected %s)", event.Status, derived)
+		}
+	})
+
+	t.Run("Filters by derived status", func(t *testing.T) {
+		all, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{"filter": map[string]interface{}{"visible": true}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The filter must agree with the status reported on each event, whatever the time zone of the host.
+		counts := make(map[string]int)
+		for _, v := range all.Events {
+			counts[v.Status]++
+		}
+
+		if counts["EVENT_STATUS_FINISHED"] == 0 {
+			t.Fatal("Expected finished events")
+		}
+
+		for _, status := range []string{"EVENT_STATUS_SCHEDULED", "EVENT_STATUS_IN_PLAY", "EVENT_STATUS_FINISHED"} {
+			filtered, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{
+				"filter": map[string]interface{}{"visible": true, "statuses": []string{status}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(filtered.Events) != counts[status] {
+				t.Errorf("Unexpected number of %s events: %d (expected %d)", status, len(filtered.Events), counts[status])
+			}
+
+			for _, v := range filtered.Events {
+				if v.Status != status {
+					t.Errorf("Unexpected event %s with status %s (expected %s)", v.ID, v.Status, status)
+				}
+			}
+		}
+	})
+
+	t.Run("Rejects statuses derived from times", func(t *testing.T) {
+		if resp, _ := setStatus(t, "4", "EVENT_STATUS_IN_PLAY"); resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Returns not found for a missing event", func(t *testing.T) {
+		if resp, _ := setStatus(t, "999", "EVENT_STATUS_SUSPENDED"); resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusNotFound)
+		}
+	})
+}
+
+func TestEventsCalendar(t *testing.T) {
+	resp, err := http.Get(apiHost + "v1/calendar/events.ics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected status code: %d (expected %d)", resp.StatusCode, http.StatusOK)
+	}
+
+	if contentType := resp.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "text/calendar") {
+		t.Errorf("Unexpected content type %q", contentType)
+	}
+
+	var b bytes.Buffer
+	_, _ = b.ReadFrom(resp.Body)
+	calendar := b.String()
+
+	if !strings.HasPrefix(calendar, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(calendar, "END:VCALENDAR\r\n") {
+		t.Fatalf("Unexpected calendar %q", calendar)
+	}
+
+	t.Run("Renders upcoming visible events", func(t *testing.T) {
+		// Events starting within the next minute may have started by the time the calendar was read, so they are skipped.
+		from := time.Now().Add(time.Minute)
+		upcoming, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{
+			"filter": map[string]interface{}{"visible": true, "advertised_start_from": from.Format(time.RFC3339)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range upcoming.Events {
+			if !strings.Contains(calendar, "UID:event-"+v.ID+"@sports.entain\r\n") {
+				t.Errorf("Expected event %s in the calendar", v.ID)
+			}
+		}
+
+		if count := strings.Count(calendar, "BEGIN:VEVENT\r\n"); count < len(upcoming.Events) {
+			t.Errorf("Unexpected number of events: %d (expected at least %d)", count, len(upcoming.Events))
+		}
+
+		hidden, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{
+			"filter": map[string]interface{}{"visibility": "VISIBILITY_HIDDEN"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range hidden.Events {
+			if strings.Contains(calendar, "UID:event-"+v.ID+"@sports.entain\r\n") {
+				t.Errorf("Unexpected hidden event %s in the calendar", v.ID)
+			}
+		}
+	})
+
+	t.Run("Rejects invalid visibility filters", func(t *testing.T) {
+		for _, query := range []string{"visible=maybe", "visibility=sometimes"} {
+			resp, err := http.Get(apiHost + "v1/calendar/events.ics?" + query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("Unexpected status code for %q: %d (expected %d)", query, resp.StatusCode, http.StatusBadRequest)
+			}
+		}
+	})
+}
+
+// expectedStatus derives the status an event without an override has from its start and end times.
+func expectedStatus(event Event) string {
+	now := time.Now()
+	start, _ := time.Parse(time.RFC3339, event.StartTime)
+	end, _ := time.Parse(time.RFC3339, event.EndTime)
+
+	switch {
+	case now.Before(start):
+		return "EVENT_STATUS_SCHEDULED"
+	case now.Before(end):
+		return "EVENT_STATUS_IN_PLAY"
+	}
+
+	return "EVENT_STATUS_FINISHED"
+}
+
+func getEvent(id string) (*Event, error) {
+	resp, err := http.Get(apiHost + "v1/events/" + id)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("unexpected status code")
+	}
+
+	var body struct {
+		Event *Event `json:"event"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, errors.New("failed to decode JSON response")
+	}
+
+	return body.Event, nil
+}
+
+func TestListVenues(t *testing.T) {
+	body, _ := json.Marshal(map[string]interface{}{"filter": map[string]interface{}{"country": "gbr"}})
+	resp, err := http.Post(apiHost+"v1/list-sports-venues", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var listResp struct {
+		Venues []struct {
+			ID       string `json:"id"`
+			Name     string `json:"name"`
+			City     string `json:"city"`
+			Country  string `json:"country"`
+			Timezone string `json:"timezone"`
+		} `json:"venues"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+		t.Fatalf("Failed to decode JSON response: %v", err)
+	}
+
+	if len(listResp.Venues) == 0 {
+		t.Fatal("Expected venues in GBR")
+	}
+
+	for _, v := range listResp.Venues {
+		if v.Country != "GBR" || v.Timezone != "Europe/London" {
+			t.Errorf("Unexpected venue %+v", v)
+		}
+	}
+
+	venueID := listResp.Venues[0].ID
+	events, err := makePostRequest(apiHost+"v1/list-events", map[string]interface{}{"filter": map[string]interface{}{"venue_ids": []string{venueID}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events.Events) == 0 {
+		t.Fatalf("Expected events at venue %s", venueID)
+	}
+
+	london, _ := time.LoadLocation("Europe/London")
+	for _, v := range events.Events {
+		advertised, _ := time.Parse(time.RFC3339, v.AdvertisedStartTime)
+		local, err := time.Parse(time.RFC3339, v.LocalAdvertisedStartTime)
+		if err != nil {
+			t.Fatalf("Unexpected local start time %q of event %s: %v", v.LocalAdvertisedStartTime, v.ID, err)
+		}
+
+		if v.VenueID != venueID || !local.Equal(advertised) || local.Format(time.RFC3339) != advertised.In(london).Format(time.RFC3339) {
+			t.Errorf("Unexpected event %s at venue %s starting %s (advertised %s)", v.ID, v.VenueID, v.LocalAdvertisedStartTime, v.AdvertisedStartTime)
+		}
+
+		if location := listResp.Venues[0].Name + ", " + listResp.Venues[0].City; v.Location != location {
+			t.Errorf("Unexpected location %q of event %s, expected %q", v.Location, v.ID, location)
+		}
+	}
+}
+
 func makePostRequest(url string, requestBody interface{}) (*listEventsResponse, error) {
 	// Marshal the request body to JSON bytes
 	requestBodyJSON, err := json.Marshal(requestBody)